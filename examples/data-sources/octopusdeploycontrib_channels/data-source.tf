@@ -0,0 +1,7 @@
+data "octopusdeploycontrib_channels" "web" {
+  project_id = "Projects-1"
+}
+
+output "default_channel_id" {
+  value = one([for channel in data.octopusdeploycontrib_channels.web.channels : channel.id if channel.is_default])
+}
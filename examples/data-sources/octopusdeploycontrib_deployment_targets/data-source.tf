@@ -0,0 +1,11 @@
+data "octopusdeploycontrib_deployment_targets" "all" {}
+
+data "octopusdeploycontrib_deployment_targets" "healthy_web_servers" {
+  roles           = ["web-server"]
+  environment_ids = ["Environments-3"]
+  health_statuses = ["Healthy", "HasWarnings"]
+}
+
+output "healthy_web_server_names" {
+  value = [for target in data.octopusdeploycontrib_deployment_targets.healthy_web_servers.deployment_targets : target.name]
+}
@@ -10,3 +10,7 @@ data "octopusdeploycontrib_environment" "by_space_and_name" {
   space_id = "Spaces-142"
   name     = "SpinUp"
 }
+
+output "development_uses_guided_failure" {
+  value = data.octopusdeploycontrib_environment.by_name.use_guided_failure
+}
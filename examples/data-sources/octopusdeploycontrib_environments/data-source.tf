@@ -0,0 +1,14 @@
+data "octopusdeploycontrib_environments" "all" {}
+
+data "octopusdeploycontrib_environments" "production" {
+  names = ["Staging", "Production"]
+}
+
+data "octopusdeploycontrib_environments" "spin_up" {
+  space_id     = "Spaces-142"
+  partial_name = "SpinUp"
+}
+
+output "environment_ids_in_order" {
+  value = [for environment in data.octopusdeploycontrib_environments.all.environments : environment.id]
+}
@@ -0,0 +1,11 @@
+data "octopusdeploycontrib_feed" "built_in" {
+  id = "feeds-builtin"
+}
+
+data "octopusdeploycontrib_feed" "docker_hub" {
+  name = "Docker Hub"
+}
+
+output "built_in_retention_days" {
+  value = data.octopusdeploycontrib_feed.built_in.delete_unreleased_packages_after_days
+}
@@ -0,0 +1,7 @@
+data "octopusdeploycontrib_lifecycle" "standard" {
+  name = "Standard"
+}
+
+output "phase_names" {
+  value = data.octopusdeploycontrib_lifecycle.standard.phases[*].name
+}
@@ -10,3 +10,7 @@ data "octopusdeploycontrib_project" "by_space_and_name" {
   space_id = "Spaces-142"
   name     = "Instance Infrastructure"
 }
+
+data "octopusdeploycontrib_project" "by_slug" {
+  slug = "petclinic"
+}
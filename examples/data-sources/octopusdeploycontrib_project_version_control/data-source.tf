@@ -0,0 +1,7 @@
+data "octopusdeploycontrib_project_version_control" "web" {
+  project_id = "Projects-1"
+}
+
+output "unprotected_branches" {
+  value = [for branch in data.octopusdeploycontrib_project_version_control.web.branches : branch.name if !branch.is_protected]
+}
@@ -0,0 +1,15 @@
+data "octopusdeploycontrib_projects" "all" {}
+
+data "octopusdeploycontrib_projects" "enabled_in_group" {
+  project_group_id = "ProjectGroups-1"
+  is_disabled      = false
+}
+
+data "octopusdeploycontrib_projects" "connected_to_tenant" {
+  tenant_id    = "Tenants-381"
+  partial_name = "Pet"
+}
+
+output "project_names" {
+  value = [for project in data.octopusdeploycontrib_projects.all.projects : project.name]
+}
@@ -0,0 +1,19 @@
+data "octopusdeploycontrib_runbook" "restart" {
+  project_id = "Projects-1"
+  name       = "Restart service"
+}
+
+resource "octopusdeploycontrib_project_trigger" "nightly_restart" {
+  name       = "Nightly restart"
+  project_id = data.octopusdeploycontrib_runbook.restart.project_id
+
+  cron_expression_schedule = {
+    cron_expression = "0 2 * * *"
+    timezone        = "UTC"
+  }
+
+  run_runbook_action = {
+    runbook_id      = data.octopusdeploycontrib_runbook.restart.id
+    environment_ids = ["Environments-2"]
+  }
+}
@@ -0,0 +1,7 @@
+data "octopusdeploycontrib_tag_set" "region" {
+  name = "Region"
+}
+
+output "region_tags" {
+  value = data.octopusdeploycontrib_tag_set.region.canonical_tag_names
+}
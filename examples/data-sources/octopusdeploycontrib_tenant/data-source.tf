@@ -10,3 +10,11 @@ data "octopusdeploycontrib_tenant" "by_space_and_name" {
   space_id = "Spaces-142"
   name     = "Internal"
 }
+
+data "octopusdeploycontrib_tenant" "by_tags" {
+  tags = ["Region/Brisbane", "Tier/Gold"]
+}
+
+output "connected_project_ids" {
+  value = keys(data.octopusdeploycontrib_tenant.by_name.project_environments)
+}
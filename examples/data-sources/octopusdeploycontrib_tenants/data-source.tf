@@ -0,0 +1,19 @@
+data "octopusdeploycontrib_tenants" "all" {}
+
+data "octopusdeploycontrib_tenants" "gold_in_any_region" {
+  all_tags = ["Tier/Gold"]
+  any_tags = ["Region/Brisbane", "Region/Sydney"]
+}
+
+data "octopusdeploycontrib_tenants" "connected_to_project" {
+  project_id   = "Projects-101"
+  partial_name = "Vet"
+}
+
+data "octopusdeploycontrib_tenants" "clones" {
+  cloned_from_tenant_id = "Tenants-381"
+}
+
+output "tenant_ids" {
+  value = [for tenant in data.octopusdeploycontrib_tenants.gold_in_any_region.tenants : tenant.id]
+}
@@ -0,0 +1,36 @@
+data "octopusdeploycontrib_worker_pools" "all" {}
+
+data "octopusdeploycontrib_worker_pools" "dynamic" {
+  worker_pool_type = "DynamicWorkerPool"
+}
+
+data "octopusdeploycontrib_worker_pools" "ubuntu" {
+  names = ["Hosted Ubuntu"]
+}
+
+resource "octopusdeploycontrib_deployment_process" "web" {
+  project_id = "Projects-1"
+
+  steps = [
+    {
+      name = "Smoke test"
+
+      actions = [
+        {
+          name           = "Smoke test"
+          action_type    = "Octopus.Script"
+          worker_pool_id = data.octopusdeploycontrib_worker_pools.ubuntu.worker_pools[0].id
+
+          script = {
+            syntax = "Bash"
+            body   = "curl --fail https://example.com/health"
+          }
+
+          properties = {
+            "Octopus.Action.RunOnServer" = "true"
+          }
+        },
+      ]
+    },
+  ]
+}
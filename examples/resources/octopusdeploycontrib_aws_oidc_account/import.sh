@@ -0,0 +1,5 @@
+# Accounts in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_aws_oidc_account.example Accounts-1
+
+# Accounts in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_aws_oidc_account.example Spaces-2/Accounts-1
@@ -0,0 +1,5 @@
+# Channels in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_channel.example Channels-1
+
+# Channels in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_channel.example Spaces-2/Channels-1
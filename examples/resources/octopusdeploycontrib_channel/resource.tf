@@ -0,0 +1,15 @@
+resource "octopusdeploycontrib_channel" "hotfix" {
+  project_id   = "Projects-1"
+  name         = "Hotfix"
+  description  = "Patches to the current major version"
+  lifecycle_id = "Lifecycles-2"
+
+  rule {
+    action_packages = [
+      { deployment_action = "Deploy web app" },
+      { deployment_action = "Run migrations", package_reference = "migrator" },
+    ]
+    version_range = "[2.0,3.0)"
+    tag           = "^hotfix"
+  }
+}
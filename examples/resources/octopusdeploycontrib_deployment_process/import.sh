@@ -0,0 +1,6 @@
+# Deployment processes in the provider's default space can be imported by ID, or by the project's ID
+terraform import octopusdeploycontrib_deployment_process.example deploymentprocess-Projects-1
+terraform import octopusdeploycontrib_deployment_process.example Projects-1
+
+# Deployment processes in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_deployment_process.example Spaces-2/deploymentprocess-Projects-1
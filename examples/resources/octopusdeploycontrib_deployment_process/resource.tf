@@ -0,0 +1,56 @@
+resource "octopusdeploycontrib_deployment_process" "web" {
+  project_id = "Projects-1"
+
+  steps = [
+    {
+      name                = "Deploy web app"
+      package_requirement = "AfterPackageAcquisition"
+      target_roles        = ["web-server"]
+
+      properties = {
+        "Octopus.Action.MaxParallelism" = "2"
+      }
+
+      actions = [
+        {
+          name        = "Deploy web app"
+          action_type = "Octopus.TentaclePackage"
+
+          packages = [
+            {
+              package_id           = "web-app"
+              feed_id              = "Feeds-1"
+              acquisition_location = "ExecutionTarget"
+            },
+          ]
+        },
+      ]
+    },
+    {
+      name                 = "Smoke test"
+      start_trigger        = "StartAfterPrevious"
+      condition            = "Variable"
+      condition_expression = "#{if Octopus.Deployment.Error}false#{else}true#{/if}"
+
+      actions = [
+        {
+          name                  = "Smoke test"
+          action_type           = "Octopus.Script"
+          worker_pool_id        = "WorkerPools-1"
+          excluded_environments = ["Environments-1"]
+          channels              = ["Channels-1"]
+          tenant_tags           = ["Region/Europe"]
+
+          script = {
+            syntax = "Bash"
+            body   = "curl --fail https://example.com/health"
+          }
+
+          properties = {
+            "Octopus.Action.RunOnServer" = "true"
+          }
+        },
+      ]
+    },
+  ]
+}
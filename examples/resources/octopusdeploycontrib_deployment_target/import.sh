@@ -0,0 +1,5 @@
+# Deployment targets in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_deployment_target.example Machines-1
+
+# Deployment targets in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_deployment_target.example Spaces-2/Machines-1
@@ -0,0 +1,67 @@
+resource "octopusdeploycontrib_deployment_target" "web" {
+  name              = "web-1"
+  environment_ids   = ["Environments-1", "Environments-2"]
+  roles             = ["web-server"]
+  machine_policy_id = "MachinePolicies-1"
+
+  listening_tentacle = {
+    uri        = "https://web-1.example.com:10933/"
+    thumbprint = "8A7BA2AB2F4A2ED3BD6A4F3D1B3A0E3B3E9D4F2A"
+  }
+}
+
+resource "octopusdeploycontrib_deployment_target" "store" {
+  name                              = "store-0042"
+  environment_ids                   = ["Environments-3"]
+  roles                             = ["point-of-sale"]
+  tenanted_deployment_participation = "Tenanted"
+  tenant_tags                       = ["Region/Europe"]
+
+  polling_tentacle = {
+    uri        = "poll://2kd8r7sbvpx6e4nt9q3m/"
+    thumbprint = "0C1F4D6A3E9B8A7C2D5E6F1A9B3C4D7E8F2A1B6C"
+  }
+}
+
+resource "octopusdeploycontrib_aws_oidc_account" "eks" {
+  name                              = "EKS deployments"
+  role_arn                          = "arn:aws:iam::123456789012:role/octopus-eks"
+  tenanted_deployment_participation = "Untenanted"
+}
+
+resource "octopusdeploycontrib_deployment_target" "eks" {
+  name            = "eks-production"
+  environment_ids = ["Environments-3"]
+  roles           = ["k8s"]
+
+  kubernetes = {
+    cluster_url            = "https://ABCDEF0123456789.gr7.ap-southeast-2.eks.amazonaws.com"
+    cluster_name           = "production"
+    aws_account_id         = octopusdeploycontrib_aws_oidc_account.eks.id
+    namespace              = "web"
+    default_worker_pool_id = "WorkerPools-2"
+  }
+}
+
+resource "octopusdeploycontrib_deployment_target" "aws" {
+  name            = "ap-southeast-2"
+  environment_ids = ["Environments-3"]
+  roles           = ["aws"]
+
+  cloud_region = {
+    default_worker_pool_id = "WorkerPools-2"
+  }
+}
+
+resource "octopusdeploycontrib_deployment_target" "air_gapped" {
+  name            = "air-gapped-site"
+  environment_ids = ["Environments-3"]
+  roles           = ["web-server"]
+
+  offline_drop = {
+    destination_type       = "FileSystem"
+    drop_folder_path       = "\\\\fileserver\\drops"
+    applications_directory = "C:\\Applications"
+    working_directory      = "C:\\Octopus"
+  }
+}
@@ -0,0 +1,6 @@
+resource "octopusdeploycontrib_dynamic_worker_pool" "ubuntu" {
+  name        = "Hosted Ubuntu"
+  description = "Dynamic workers leased from Octopus Cloud"
+  worker_type = "Ubuntu2204"
+  is_default  = true
+}
@@ -0,0 +1,6 @@
+# Environments in the provider's default space can be imported by ID or name
+terraform import octopusdeploycontrib_environment.example Environments-1
+terraform import octopusdeploycontrib_environment.example "Production (EU)"
+
+# Environments in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_environment.example Spaces-2/Environments-1
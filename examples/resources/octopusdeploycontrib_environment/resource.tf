@@ -0,0 +1,15 @@
+resource "octopusdeploycontrib_environment" "production_eu" {
+  name                         = "Production (EU)"
+  description                  = "Production workloads hosted in Europe"
+  sort_order                   = 4
+  use_guided_failure           = true
+  allow_dynamic_infrastructure = true
+
+  jira_extension_settings = {
+    environment_type = "production"
+  }
+
+  servicenow_extension_settings = {
+    is_enabled = true
+  }
+}
@@ -0,0 +1,5 @@
+# Feeds in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_feed.example Feeds-1
+
+# Feeds in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_feed.example Spaces-2/Feeds-1
@@ -0,0 +1,46 @@
+resource "octopusdeploycontrib_feed" "nuget" {
+  name = "NuGet"
+
+  nuget = {
+    feed_uri      = "https://api.nuget.org/v3/index.json"
+    enhanced_mode = true
+  }
+}
+
+resource "octopusdeploycontrib_feed" "docker_hub" {
+  name = "Docker Hub"
+
+  docker = {
+    feed_uri    = "https://index.docker.io"
+    api_version = "v2"
+    username    = "octopus-bot"
+    password    = var.docker_hub_token
+  }
+}
+
+resource "octopusdeploycontrib_feed" "ecr" {
+  name                                 = "ECR"
+  package_acquisition_location_options = ["ExecutionTarget", "NotAcquired"]
+
+  ecr = {
+    region = "ap-southeast-2"
+
+    oidc_authentication = {
+      role_arn     = "arn:aws:iam::000000000000:role/octopus-ecr"
+      subject_keys = ["space", "feed"]
+    }
+  }
+}
+
+resource "octopusdeploycontrib_feed" "charts" {
+  name = "Bitnami charts"
+
+  oci = {
+    feed_uri = "oci://registry-1.docker.io/bitnamicharts"
+  }
+}
+
+variable "docker_hub_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,5 @@
+# Git credentials in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_git_credential.example GitCredentials-1
+
+# Git credentials in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_git_credential.example Spaces-2/GitCredentials-1
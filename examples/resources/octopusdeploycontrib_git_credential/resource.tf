@@ -0,0 +1,14 @@
+resource "octopusdeploycontrib_git_credential" "github" {
+  name        = "GitHub"
+  description = "Machine user for the example organisation"
+  username    = "octopus-bot"
+  password    = var.github_token
+
+  # bump after rotating the token to send it to Octopus again
+  password_version = "2024-06"
+}
+
+variable "github_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,5 @@
+# Library variable sets in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_library_variable_set.example LibraryVariableSets-1
+
+# Library variable sets in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_library_variable_set.example Spaces-2/LibraryVariableSets-1
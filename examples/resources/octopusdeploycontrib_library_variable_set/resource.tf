@@ -0,0 +1,15 @@
+resource "octopusdeploycontrib_library_variable_set" "database" {
+  name        = "Database"
+  description = "Connection settings shared by every service"
+
+  templates = {
+    "Database.Name" = {
+      label     = "Database name"
+      help_text = "Name of the tenant's database"
+    }
+    "Database.Password" = {
+      label        = "Database password"
+      control_type = "Sensitive"
+    }
+  }
+}
@@ -0,0 +1,5 @@
+# Variables are imported by the ID of the owning library variable set and the ID of the variable
+terraform import octopusdeploycontrib_library_variable_set_variable.example LibraryVariableSets-1:f6b5e8a4-9c1d-4c2e-8f0a-2b7c3d4e5f60
+
+# Variables in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_library_variable_set_variable.example Spaces-2/LibraryVariableSets-1:f6b5e8a4-9c1d-4c2e-8f0a-2b7c3d4e5f60
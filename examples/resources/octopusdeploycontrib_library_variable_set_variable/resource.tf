@@ -0,0 +1,9 @@
+resource "octopusdeploycontrib_library_variable_set_variable" "server" {
+  library_variable_set_id = "LibraryVariableSets-1"
+  name                    = "Database.Server"
+  value                   = "db.production.internal"
+
+  scope = {
+    environment_ids = ["Environments-3"]
+  }
+}
@@ -0,0 +1,5 @@
+# Lifecycles in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_lifecycle.example Lifecycles-1
+
+# Lifecycles in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_lifecycle.example Spaces-2/Lifecycles-1
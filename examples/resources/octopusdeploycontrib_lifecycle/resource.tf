@@ -0,0 +1,38 @@
+resource "octopusdeploycontrib_lifecycle" "standard" {
+  name        = "Standard"
+  description = "Development, then test, then production"
+
+  release_retention_policy = {
+    quantity_to_keep = 30
+    unit             = "Days"
+  }
+
+  tentacle_retention_policy = {
+    quantity_to_keep = 3
+    unit             = "Items"
+  }
+
+  phases = [
+    {
+      name                         = "Development"
+      automatic_deployment_targets = ["Environments-1"]
+      release_retention_policy = {
+        quantity_to_keep = 5
+        unit             = "Items"
+      }
+    },
+    {
+      name                        = "Test"
+      optional_deployment_targets = ["Environments-2", "Environments-3"]
+      is_optional_phase           = true
+    },
+    {
+      name                                  = "Production"
+      optional_deployment_targets           = ["Environments-4", "Environments-5"]
+      minimum_environments_before_promotion = 1
+      release_retention_policy = {
+        should_keep_forever = true
+      }
+    },
+  ]
+}
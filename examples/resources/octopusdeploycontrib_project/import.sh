@@ -0,0 +1,5 @@
+# Projects in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_project.example Projects-1
+
+# Projects in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_project.example Spaces-2/Projects-1
@@ -0,0 +1,22 @@
+resource "octopusdeploycontrib_project" "web" {
+  name                     = "Web"
+  description              = "The public website"
+  project_group_id         = "ProjectGroups-1"
+  lifecycle_id             = "Lifecycles-1"
+  tenanted_deployment_mode = "TenantedOrUntenanted"
+
+  release_versioning_strategy = {
+    donor_package_action = "Deploy web app"
+  }
+
+  connectivity_policy = {
+    skip_machine_behavior = "SkipUnavailableMachines"
+    target_roles          = ["web-server"]
+  }
+
+  included_library_variable_set_ids = ["LibraryVariableSets-1"]
+
+  # the deployment process is edited in the Octopus UI, which can change the
+  # donor package's step
+  ignore_server_managed_fields = true
+}
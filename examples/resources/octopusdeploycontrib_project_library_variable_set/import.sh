@@ -0,0 +1,5 @@
+# Included library variable sets are imported by project ID and library variable set ID
+terraform import octopusdeploycontrib_project_library_variable_set.example Projects-2:LibraryVariableSets-1
+
+# Projects in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_project_library_variable_set.example Spaces-2/Projects-2:LibraryVariableSets-1
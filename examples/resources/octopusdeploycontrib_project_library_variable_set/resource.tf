@@ -0,0 +1,4 @@
+resource "octopusdeploycontrib_project_library_variable_set" "database" {
+  project_id              = "Projects-2"
+  library_variable_set_id = "LibraryVariableSets-1"
+}
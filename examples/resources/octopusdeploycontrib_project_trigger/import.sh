@@ -0,0 +1,5 @@
+# Triggers in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_project_trigger.example ProjectTriggers-1
+
+# Triggers in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_project_trigger.example Spaces-2/ProjectTriggers-1
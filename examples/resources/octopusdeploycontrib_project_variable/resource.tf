@@ -0,0 +1,33 @@
+resource "octopusdeploycontrib_project_variable" "connection_string" {
+  project_id = "Projects-2"
+  name       = "Database.ConnectionString"
+  type       = "Sensitive"
+  value      = var.connection_string
+
+  scope = {
+    environment_ids = ["Environments-2"]
+    tenant_tags     = ["Tier/Gold"]
+  }
+}
+
+resource "octopusdeploycontrib_project_variable" "log_level" {
+  project_id  = "Projects-2"
+  name        = "LogLevel"
+  value       = "Information"
+  description = "Minimum level written to the application log"
+
+  prompt = {
+    label        = "Log level"
+    is_required  = true
+    control_type = "Select"
+    select_options = [
+      { value = "Debug", display_name = "Debug" },
+      { value = "Information", display_name = "Information" },
+    ]
+  }
+}
+
+variable "connection_string" {
+  type      = string
+  sensitive = true
+}
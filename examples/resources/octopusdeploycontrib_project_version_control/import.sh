@@ -0,0 +1,5 @@
+# Project Git settings in the provider's default space can be imported by project ID
+terraform import octopusdeploycontrib_project_version_control.example Projects-1
+
+# Project Git settings in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_project_version_control.example Spaces-2/Projects-1
@@ -0,0 +1,24 @@
+resource "octopusdeploycontrib_project_version_control" "web" {
+  project_id         = "Projects-1"
+  url                = "https://github.com/example/web.git"
+  default_branch     = "main"
+  protected_branches = ["main", "release/*"]
+  git_credential_id  = "GitCredentials-1"
+
+  # only used when the project is converted
+  commit_message        = "Convert Web to Config as Code"
+  initial_commit_branch = "octopus/initial"
+}
+
+resource "octopusdeploycontrib_project_version_control" "api" {
+  project_id = "Projects-2"
+  url        = "https://gitlab.example.com/platform/api.git"
+  base_path  = ".octopus/api"
+  username   = "octopus"
+  password   = var.gitlab_token
+}
+
+variable "gitlab_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+# Runbooks in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_runbook.example Runbooks-1
+
+# Runbooks can also be imported by project ID and name
+terraform import octopusdeploycontrib_runbook.example "Projects-1:Restart service"
+
+# Runbooks in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_runbook.example Spaces-2/Runbooks-1
@@ -0,0 +1,18 @@
+resource "octopusdeploycontrib_runbook" "restart" {
+  project_id  = "Projects-1"
+  name        = "Restart service"
+  description = "Restarts the service on every web server"
+
+  environment_scope  = "Specified"
+  environments       = ["Environments-1", "Environments-2"]
+  multi_tenancy_mode = "TenantedOrUntenanted"
+
+  connectivity_policy = {
+    skip_machine_behavior = "SkipUnavailableMachines"
+    target_roles          = ["web-server"]
+  }
+
+  run_retention_policy = {
+    quantity_to_keep = 30
+  }
+}
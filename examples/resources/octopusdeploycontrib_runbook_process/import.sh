@@ -0,0 +1,6 @@
+# Runbook processes in the provider's default space can be imported by ID, or by the runbook's ID
+terraform import octopusdeploycontrib_runbook_process.example RunbookProcess-Runbooks-1
+terraform import octopusdeploycontrib_runbook_process.example Runbooks-1
+
+# Runbook processes in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_runbook_process.example Spaces-2/RunbookProcess-Runbooks-1
@@ -0,0 +1,65 @@
+resource "octopusdeploycontrib_runbook" "restart" {
+  project_id = "Projects-1"
+  name       = "Restart service"
+}
+
+resource "octopusdeploycontrib_runbook_process" "restart" {
+  runbook_id = octopusdeploycontrib_runbook.restart.id
+  publish    = true
+
+  steps = [
+    {
+      name = "Restart service"
+
+      actions = [
+        {
+          name           = "Restart service"
+          action_type    = "Octopus.Script"
+          worker_pool_id = "WorkerPools-1"
+          environments   = ["Environments-1"]
+
+          container = {
+            feed_id = "Feeds-2"
+            image   = "octopusdeploy/worker-tools:ubuntu.22.04"
+          }
+
+          script = {
+            syntax = "Bash"
+            body   = "systemctl restart my-service"
+          }
+
+          properties = {
+            "Octopus.Action.RunOnServer" = "true"
+          }
+        },
+      ]
+    },
+    {
+      name      = "Notify on failure"
+      condition = "Failure"
+
+      actions = [
+        {
+          name        = "Run notification script"
+          action_type = "Octopus.Script"
+
+          script = {
+            file_name  = "notify.sh"
+            parameters = "--channel ops"
+          }
+
+          packages = [
+            {
+              package_id = "ops-scripts"
+              feed_id    = "Feeds-1"
+            },
+          ]
+
+          properties = {
+            "Octopus.Action.RunOnServer" = "true"
+          }
+        },
+      ]
+    },
+  ]
+}
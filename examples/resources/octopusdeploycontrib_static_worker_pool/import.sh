@@ -0,0 +1,5 @@
+# Static worker pools in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_static_worker_pool.example WorkerPools-1
+
+# Static worker pools in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_static_worker_pool.example Spaces-2/WorkerPools-1
@@ -0,0 +1,5 @@
+resource "octopusdeploycontrib_static_worker_pool" "build" {
+  name        = "Build workers"
+  description = "Self-hosted workers for builds and packaging"
+  sort_order  = 2
+}
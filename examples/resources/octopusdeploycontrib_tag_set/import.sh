@@ -0,0 +1,5 @@
+# Tag sets in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_tag_set.example TagSets-1
+
+# Tag sets in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_tag_set.example Spaces-2/TagSets-1
@@ -0,0 +1,16 @@
+resource "octopusdeploycontrib_tag_set" "region" {
+  name        = "Region"
+  description = "Where the tenant is hosted"
+
+  tags = [
+    {
+      name  = "Europe"
+      color = "#1565C0"
+    },
+    {
+      name        = "North America"
+      color       = "#2E7D32"
+      description = "United States and Canada"
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+# Tenants in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_tenant.example Tenants-1
+
+# Tenants in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_tenant.example Spaces-2/Tenants-1
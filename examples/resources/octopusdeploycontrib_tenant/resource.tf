@@ -0,0 +1,13 @@
+resource "octopusdeploycontrib_tenant" "brisbane_vet" {
+  name        = "Brisbane Vet"
+  description = "Veterinary clinic in Brisbane"
+  tenant_tags = ["Region/Brisbane", "Tier/Gold"]
+  logo_path   = "${path.module}/logos/brisbane-vet.png"
+}
+
+# Copies the variables and project connections of an existing tenant
+resource "octopusdeploycontrib_tenant" "sydney_vet" {
+  name                 = "Sydney Vet"
+  tenant_tags          = ["Region/Sydney", "Tier/Gold"]
+  clone_from_tenant_id = octopusdeploycontrib_tenant.brisbane_vet.id
+}
@@ -0,0 +1,5 @@
+# Values are imported by tenant ID, library variable set ID and template ID
+terraform import octopusdeploycontrib_tenant_common_variable.example Tenants-381:LibraryVariableSets-1:2e1a6d8c-58f1-4b8e-9d3c-0f2a7b6c5d41
+
+# Tenants in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_tenant_common_variable.example Spaces-2/Tenants-381:LibraryVariableSets-1:2e1a6d8c-58f1-4b8e-9d3c-0f2a7b6c5d41
@@ -0,0 +1,6 @@
+resource "octopusdeploycontrib_tenant_common_variable" "database_name" {
+  tenant_id               = "Tenants-381"
+  library_variable_set_id = "LibraryVariableSets-1"
+  template_id             = "2e1a6d8c-58f1-4b8e-9d3c-0f2a7b6c5d41"
+  value                   = "brisbane_vet"
+}
@@ -0,0 +1,5 @@
+# Connections are identified by tenant_id:project_id[:environment_id_1[+environment_id_n]]
+terraform import octopusdeploycontrib_tenant_connection.example Tenants-1:Projects-1:Environments-1+Environments-2
+
+# Connections in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_tenant_connection.example Spaces-2/Tenants-1:Projects-1
@@ -0,0 +1,5 @@
+# Values are imported by tenant ID, project ID, environment ID and template ID
+terraform import octopusdeploycontrib_tenant_project_variable.example Tenants-381:Projects-2:Environments-3:8d4f2b1a-3c6e-4f7a-9b0d-1e2f3a4b5c6d
+
+# Tenants in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_tenant_project_variable.example Spaces-2/Tenants-381:Projects-2:Environments-3:8d4f2b1a-3c6e-4f7a-9b0d-1e2f3a4b5c6d
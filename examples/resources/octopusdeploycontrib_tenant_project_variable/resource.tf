@@ -0,0 +1,12 @@
+resource "octopusdeploycontrib_tenant_project_variable" "api_key" {
+  tenant_id      = "Tenants-381"
+  project_id     = "Projects-2"
+  environment_id = "Environments-3"
+  template_id    = "8d4f2b1a-3c6e-4f7a-9b0d-1e2f3a4b5c6d"
+  value          = var.api_key
+}
+
+variable "api_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,5 @@
+# Workers in the provider's default space can be imported by ID
+terraform import octopusdeploycontrib_worker.example Workers-1
+
+# Workers in another space are prefixed with the space ID
+terraform import octopusdeploycontrib_worker.example Spaces-2/Workers-1
@@ -0,0 +1,20 @@
+resource "octopusdeploycontrib_static_worker_pool" "build" {
+  name = "Build workers"
+}
+
+resource "octopusdeploycontrib_worker" "listening" {
+  name                = "build-worker-1"
+  communication_style = "Listening"
+  uri                 = "https://build-worker-1.example.com:10933/"
+  thumbprint          = "8A7BA2AB2F4A2ED3BD6A4F3D1B3A0E3B3E9D4F2A"
+  machine_policy_id   = "MachinePolicies-1"
+  worker_pool_ids     = [octopusdeploycontrib_static_worker_pool.build.id]
+}
+
+resource "octopusdeploycontrib_worker" "polling" {
+  name                = "build-worker-2"
+  communication_style = "Polling"
+  uri                 = "poll://2kd8r7sbvpx6e4nt9q3m/"
+  thumbprint          = "0C1F4D6A3E9B8A7C2D5E6F1A9B3C4D7E8F2A1B6C"
+  worker_pool_ids     = [octopusdeploycontrib_static_worker_pool.build.id]
+}
@@ -0,0 +1,15 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+func (c *Client) GetProjectChannels(ctx context.Context, spaceID, projectID string, query channels.Query) (res *resources.Resources[*channels.Channel], err error) {
+	endpoint := fmt.Sprintf("spaces/%s/projects/%s/channels", spaceID, projectID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint).QueryStruct(query), &res)
+	return res, err
+}
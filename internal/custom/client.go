@@ -2,6 +2,7 @@ package custom
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,16 +12,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func NewClient(client *odclient.Client) *Client {
-	return &Client{client}
+var (
+	ErrReadOnly = errors.New("client is read-only")
+)
+
+// NewClient wraps the Octopus Deploy client. A read-only client refuses to
+// send anything other than GET requests.
+func NewClient(client *odclient.Client, readOnly bool) *Client {
+	return &Client{client, readOnly}
 }
 
-type Client struct{ client *odclient.Client }
+type Client struct {
+	client   *odclient.Client
+	readOnly bool
+}
 
 func (c *Client) do(ctx context.Context, client *sling.Sling, output any) error {
+	req, _ := client.Request()
+	if c.readOnly && req.Method != http.MethodGet {
+		tflog.Debug(ctx, fmt.Sprintf("%s %s was refused by read-only client", req.Method, req.URL.Path))
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+
 	failure := new(core.APIError)
 	res, err := client.Receive(output, failure)
-	req, _ := client.Request()
 
 	if res.StatusCode >= 200 && res.StatusCode < 300 {
 		tflog.Debug(ctx, fmt.Sprintf("%s %s was successful", req.Method, req.URL.Path), map[string]interface{}{
@@ -0,0 +1,57 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+type DeploymentTarget struct {
+	SpaceID                         string           `json:"SpaceId,omitempty"`
+	ID                              string           `json:"Id,omitempty"`
+	Name                            string           `json:"Name"`
+	Thumbprint                      string           `json:"Thumbprint,omitempty"`
+	URI                             string           `json:"Uri,omitempty"`
+	IsDisabled                      bool             `json:"IsDisabled"`
+	MachinePolicyID                 string           `json:"MachinePolicyId,omitempty"`
+	EnvironmentIDs                  []string         `json:"EnvironmentIds"`
+	Roles                           []string         `json:"Roles"`
+	TenantedDeploymentParticipation string           `json:"TenantedDeploymentParticipation"`
+	TenantIDs                       []string         `json:"TenantIds"`
+	TenantTags                      []string         `json:"TenantTags"`
+	HealthStatus                    string           `json:"HealthStatus,omitempty"`
+	StatusSummary                   string           `json:"StatusSummary,omitempty"`
+	Endpoint                        *MachineEndpoint `json:"Endpoint"`
+}
+
+func (c *Client) GetDeploymentTarget(ctx context.Context, spaceID, deploymentTargetID string) (res *DeploymentTarget, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/machines/%s", spaceID, deploymentTargetID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) GetDeploymentTargets(ctx context.Context, spaceID string, query machines.MachinesQuery) (res *resources.Resources[*DeploymentTarget], err error) {
+	endpoint := fmt.Sprintf("spaces/%s/machines", spaceID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint).QueryStruct(query), &res)
+	return res, err
+}
+
+func (c *Client) CreateDeploymentTarget(ctx context.Context, deploymentTarget DeploymentTarget) (res *DeploymentTarget, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/machines", deploymentTarget.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(deploymentTarget), &res)
+	return res, err
+}
+
+func (c *Client) UpdateDeploymentTarget(ctx context.Context, deploymentTarget DeploymentTarget) (res *DeploymentTarget, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/machines/%s", deploymentTarget.SpaceID, deploymentTarget.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(deploymentTarget), &res)
+	return res, err
+}
+
+func (c *Client) DeleteDeploymentTarget(ctx context.Context, spaceID, deploymentTargetID string) error {
+	endpoint := fmt.Sprintf("spaces/%s/machines/%s", spaceID, deploymentTargetID)
+	err := c.do(ctx, c.client.Sling().New().Delete(endpoint), nil)
+	return err
+}
@@ -0,0 +1,70 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// Feed holds the settings of every type of feed. The SDK's feed types drop
+// OIDC authentication and do not know OCI registries.
+type Feed struct {
+	SpaceID                           string                  `json:"SpaceId,omitempty"`
+	ID                                string                  `json:"Id,omitempty"`
+	Name                              string                  `json:"Name"`
+	FeedType                          string                  `json:"FeedType"`
+	FeedURI                           string                  `json:"FeedUri,omitempty"`
+	PackageAcquisitionLocationOptions []string                `json:"PackageAcquisitionLocationOptions,omitempty"`
+	Username                          string                  `json:"Username,omitempty"`
+	Password                          *core.SensitiveValue    `json:"Password,omitempty"`
+	DownloadAttempts                  int                     `json:"DownloadAttempts,omitempty"`
+	DownloadRetryBackoffSeconds       int                     `json:"DownloadRetryBackoffSeconds"`
+	EnhancedMode                      bool                    `json:"EnhancedMode,omitempty"`
+	APIVersion                        string                  `json:"ApiVersion,omitempty"`
+	RegistryPath                      string                  `json:"RegistryPath,omitempty"`
+	Region                            string                  `json:"Region,omitempty"`
+	AccessKey                         string                  `json:"AccessKey,omitempty"`
+	SecretKey                         *core.SensitiveValue    `json:"SecretKey,omitempty"`
+	OIDCAuthentication                *FeedOIDCAuthentication `json:"OidcAuthentication,omitempty"`
+	DeleteUnreleasedPackagesAfterDays int                     `json:"DeleteUnreleasedPackagesAfterDays,omitempty"`
+	IsBuiltInRepoSyncEnabled          bool                    `json:"IsBuiltInRepoSyncEnabled,omitempty"`
+}
+
+type FeedOIDCAuthentication struct {
+	RoleARN         string   `json:"RoleArn"`
+	SessionDuration string   `json:"SessionDuration"`
+	SubjectKeys     []string `json:"SubjectKeys"`
+}
+
+func (c *Client) GetFeed(ctx context.Context, spaceID, feedID string) (res *Feed, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/feeds/%s", spaceID, feedID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) GetFeeds(ctx context.Context, spaceID string, query feeds.FeedsQuery) (res *resources.Resources[*Feed], err error) {
+	endpoint := fmt.Sprintf("spaces/%s/feeds", spaceID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint).QueryStruct(query), &res)
+	return res, err
+}
+
+func (c *Client) CreateFeed(ctx context.Context, feed Feed) (res *Feed, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/feeds", feed.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(feed), &res)
+	return res, err
+}
+
+func (c *Client) UpdateFeed(ctx context.Context, feed Feed) (res *Feed, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/feeds/%s", feed.SpaceID, feed.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(feed), &res)
+	return res, err
+}
+
+func (c *Client) DeleteFeed(ctx context.Context, spaceID, feedID string) error {
+	endpoint := fmt.Sprintf("spaces/%s/feeds/%s", spaceID, feedID)
+	err := c.do(ctx, c.client.Sling().New().Delete(endpoint), nil)
+	return err
+}
@@ -0,0 +1,27 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+)
+
+// lifecycleBody is the body of a lifecycle request. The SDK omits an empty
+// list of phases, which leaves the phases of an existing lifecycle unchanged.
+type lifecycleBody struct {
+	*lifecycles.Lifecycle
+	Phases []*lifecycles.Phase `json:"Phases"`
+}
+
+func (c *Client) CreateLifecycle(ctx context.Context, lifecycle *lifecycles.Lifecycle) (res *lifecycles.Lifecycle, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/lifecycles", lifecycle.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(lifecycleBody{lifecycle, lifecycle.Phases}), &res)
+	return res, err
+}
+
+func (c *Client) UpdateLifecycle(ctx context.Context, lifecycle *lifecycles.Lifecycle) (res *lifecycles.Lifecycle, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/lifecycles/%s", lifecycle.SpaceID, lifecycle.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(lifecycleBody{lifecycle, lifecycle.Phases}), &res)
+	return res, err
+}
@@ -0,0 +1,37 @@
+package custom
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+
+// MachineEndpoint holds how Octopus communicates with a worker or deployment
+// target. Only the settings of the endpoint's communication style are set.
+type MachineEndpoint struct {
+	CommunicationStyle                   string                    `json:"CommunicationStyle"`
+	URI                                  string                    `json:"Uri,omitempty"`
+	Thumbprint                           string                    `json:"Thumbprint,omitempty"`
+	ClusterURL                           string                    `json:"ClusterUrl,omitempty"`
+	Namespace                            string                    `json:"Namespace,omitempty"`
+	SkipTLSVerification                  bool                      `json:"SkipTlsVerification,omitempty"`
+	ClusterCertificate                   string                    `json:"ClusterCertificate,omitempty"`
+	Authentication                       *KubernetesAuthentication `json:"Authentication,omitempty"`
+	DefaultWorkerPoolID                  string                    `json:"DefaultWorkerPoolId,omitempty"`
+	Destination                          *OfflineDropDestination   `json:"Destination,omitempty"`
+	ApplicationsDirectory                string                    `json:"ApplicationsDirectory,omitempty"`
+	WorkingDirectory                     string                    `json:"OctopusWorkingDirectory,omitempty"`
+	SensitiveVariablesEncryptionPassword *core.SensitiveValue      `json:"SensitiveVariablesEncryptionPassword,omitempty"`
+}
+
+// KubernetesAuthentication holds how Octopus authenticates with a Kubernetes
+// cluster.
+type KubernetesAuthentication struct {
+	AuthenticationType string `json:"AuthenticationType"`
+	AccountID          string `json:"AccountId,omitempty"`
+	ClusterName        string `json:"ClusterName,omitempty"`
+	UseInstanceRole    bool   `json:"UseInstanceRole"`
+	AssumeRole         bool   `json:"AssumeRole"`
+}
+
+// OfflineDropDestination holds where an offline drop writes its packages.
+type OfflineDropDestination struct {
+	DestinationType string `json:"DestinationType"`
+	DropFolderPath  string `json:"DropFolderPath,omitempty"`
+}
@@ -0,0 +1,20 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+type GitBranchesQuery struct {
+	Skip int `url:"skip,omitempty"`
+	Take int `url:"take,omitempty"`
+}
+
+func (c *Client) GetProjectGitBranches(ctx context.Context, spaceID, projectID string, query GitBranchesQuery) (res *resources.Resources[*projects.GitReference], err error) {
+	endpoint := fmt.Sprintf("spaces/%s/projects/%s/git/branches", spaceID, projectID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint).QueryStruct(query), &res)
+	return res, err
+}
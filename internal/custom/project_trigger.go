@@ -0,0 +1,32 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+)
+
+func (c *Client) GetProjectTrigger(ctx context.Context, spaceID, triggerID string) (res *triggers.ProjectTrigger, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/projecttriggers/%s", spaceID, triggerID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) CreateProjectTrigger(ctx context.Context, trigger triggers.ProjectTrigger) (res *triggers.ProjectTrigger, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/projecttriggers", trigger.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(trigger), &res)
+	return res, err
+}
+
+func (c *Client) UpdateProjectTrigger(ctx context.Context, trigger triggers.ProjectTrigger) (res *triggers.ProjectTrigger, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/projecttriggers/%s", trigger.SpaceID, trigger.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(trigger), &res)
+	return res, err
+}
+
+func (c *Client) DeleteProjectTrigger(ctx context.Context, spaceID, triggerID string) error {
+	endpoint := fmt.Sprintf("spaces/%s/projecttriggers/%s", spaceID, triggerID)
+	err := c.do(ctx, c.client.Sling().New().Delete(endpoint), nil)
+	return err
+}
@@ -0,0 +1,34 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+)
+
+func (c *Client) GetRunbookProcess(ctx context.Context, spaceID, runbookProcessID string) (res *runbooks.RunbookProcess, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/runbookProcesses/%s", spaceID, runbookProcessID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) UpdateRunbookProcess(ctx context.Context, process runbooks.RunbookProcess) (res *runbooks.RunbookProcess, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/runbookProcesses/%s", process.SpaceID, process.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(process), &res)
+	return res, err
+}
+
+func (c *Client) GetRunbookSnapshotTemplate(ctx context.Context, spaceID, runbookProcessID string) (res *runbooks.RunbookSnapshotTemplate, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/runbookProcesses/%s/runbookSnapshotTemplate", spaceID, runbookProcessID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+// PublishRunbookSnapshot creates a snapshot of the runbook and publishes it, so
+// that it is the version of the runbook which is run by triggers.
+func (c *Client) PublishRunbookSnapshot(ctx context.Context, snapshot runbooks.RunbookSnapshot) (res *runbooks.RunbookSnapshot, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/runbookSnapshots?publish=true", snapshot.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(snapshot), &res)
+	return res, err
+}
@@ -0,0 +1,40 @@
+package custom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+)
+
+func (c *Client) CloneTenant(ctx context.Context, spaceID, sourceTenantID string, request tenants.TenantCloneRequest) (res *tenants.Tenant, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/tenants", spaceID)
+	query := tenants.TenantCloneQuery{CloneTenantID: sourceTenantID}
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).QueryStruct(query).BodyJSON(request), &res)
+	return res, err
+}
+
+func (c *Client) UploadTenantLogo(ctx context.Context, spaceID, tenantID, filename string, content io.Reader) error {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(part, content); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("spaces/%s/tenants/%s/logo", spaceID, tenantID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).Set("Content-Type", writer.FormDataContentType()).Body(body), nil)
+	return err
+}
@@ -0,0 +1,20 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+)
+
+func (c *Client) GetTenantVariables(ctx context.Context, spaceID, tenantID string) (res *variables.TenantVariables, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/tenants/%s/variables", spaceID, tenantID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) UpdateTenantVariables(ctx context.Context, spaceID string, tenantVariables variables.TenantVariables) (res *variables.TenantVariables, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/tenants/%s/variables", spaceID, tenantVariables.TenantID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(tenantVariables), &res)
+	return res, err
+}
@@ -0,0 +1,42 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+)
+
+type Worker struct {
+	SpaceID         string           `json:"SpaceId,omitempty"`
+	ID              string           `json:"Id,omitempty"`
+	Name            string           `json:"Name"`
+	Thumbprint      string           `json:"Thumbprint,omitempty"`
+	URI             string           `json:"Uri,omitempty"`
+	IsDisabled      bool             `json:"IsDisabled"`
+	MachinePolicyID string           `json:"MachinePolicyId,omitempty"`
+	WorkerPoolIDs   []string         `json:"WorkerPoolIds"`
+	Endpoint        *MachineEndpoint `json:"Endpoint"`
+}
+
+func (c *Client) GetWorker(ctx context.Context, spaceID, workerID string) (res *Worker, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/workers/%s", spaceID, workerID)
+	err = c.do(ctx, c.client.Sling().New().Get(endpoint), &res)
+	return res, err
+}
+
+func (c *Client) CreateWorker(ctx context.Context, worker Worker) (res *Worker, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/workers", worker.SpaceID)
+	err = c.do(ctx, c.client.Sling().New().Post(endpoint).BodyJSON(worker), &res)
+	return res, err
+}
+
+func (c *Client) UpdateWorker(ctx context.Context, worker Worker) (res *Worker, err error) {
+	endpoint := fmt.Sprintf("spaces/%s/workers/%s", worker.SpaceID, worker.ID)
+	err = c.do(ctx, c.client.Sling().New().Put(endpoint).BodyJSON(worker), &res)
+	return res, err
+}
+
+func (c *Client) DeleteWorker(ctx context.Context, spaceID, workerID string) error {
+	endpoint := fmt.Sprintf("spaces/%s/workers/%s", spaceID, workerID)
+	err := c.do(ctx, c.client.Sling().New().Delete(endpoint), nil)
+	return err
+}
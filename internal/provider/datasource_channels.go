@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*ChannelsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*ChannelsDataSource)(nil)
+)
+
+func NewChannelsDataSource() datasource.DataSource {
+	return &ChannelsDataSource{}
+}
+
+// ChannelsDataSource defines the data source implementation.
+type ChannelsDataSource struct {
+	client *client.Client
+}
+
+// ChannelsDataSourceModel describes the data source data model.
+type ChannelsDataSourceModel struct {
+	SpaceID     types.String `tfsdk:"space_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	IDs         types.List   `tfsdk:"ids"`
+	PartialName types.String `tfsdk:"partial_name"`
+	Channels    types.List   `tfsdk:"channels"`
+}
+
+func (d *ChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_channels"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *ChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the channels in a space or project, with their version rules",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Only include channels of this project",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include channels with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include channels whose name contains this value",
+				Optional:            true,
+			},
+			"channels": schema.ListNestedAttribute{
+				MarkdownDescription: "List of channels matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the space that the channel belongs to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the channel",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the project that the channel belongs to",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the channel",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the channel",
+							Computed:            true,
+						},
+						"lifecycle_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the lifecycle used by releases in the channel, null when inherited from the project",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is the project's default channel",
+							Computed:            true,
+						},
+						"tenant_tags": schema.SetAttribute{
+							MarkdownDescription: "Canonical names of the tags of tenants which can be deployed to in the channel",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"rule": schema.ListNestedAttribute{
+							MarkdownDescription: "Version rules which packages must satisfy to be released in the channel",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The unique identifier of the rule",
+										Computed:            true,
+									},
+									"action_packages": schema.SetNestedAttribute{
+										MarkdownDescription: "The packages the rule applies to",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"deployment_action": schema.StringAttribute{
+													MarkdownDescription: "The name of the step action referencing the package",
+													Computed:            true,
+												},
+												"package_reference": schema.StringAttribute{
+													MarkdownDescription: "The name of the package reference, empty for the action's primary package",
+													Computed:            true,
+												},
+											},
+										},
+									},
+									"version_range": schema.StringAttribute{
+										MarkdownDescription: "The NuGet version range which package versions must be in",
+										Computed:            true,
+									},
+									"tag": schema.StringAttribute{
+										MarkdownDescription: "A regular expression which the pre-release tag of package versions must match",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data ChannelsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	projectID := data.ProjectID.ValueString()
+
+	ids, diags := expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query := channels.Query{
+		IDs:         ids,
+		PartialName: data.PartialName.ValueString(),
+	}
+
+	tflog.Debug(ctx, "fetching channels", map[string]interface{}{"space_id": spaceID, "project_id": projectID, "query": query})
+
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*channels.Channel], error) {
+		query.Skip, query.Take = skip, take
+		if projectID != "" {
+			return custom.NewClient(d.client, true).GetProjectChannels(ctx, spaceID, projectID, query)
+		}
+
+		return channels.Get(d.client, spaceID, query)
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch channels", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched channels", map[string]interface{}{"count": len(items)})
+
+	models := []ChannelResourceModel{}
+	for _, item := range items {
+		// the project channels endpoint does not filter by ID
+		if len(ids) > 0 && !slices.Contains(ids, item.ID) {
+			continue
+		}
+
+		model, diags := flattenChannelResourceModel(ctx, item)
+		if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+			return
+		}
+
+		models = append(models, *model)
+	}
+
+	channelSchema, ok := req.Config.Schema.GetAttributes()["channels"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for channels")
+		res.Diagnostics.AddError("Failed to fetch channels", err.Error())
+		return
+	}
+
+	channelList, diags := types.ListValueFrom(ctx, channelSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Channels = channelList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
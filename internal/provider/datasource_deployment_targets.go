@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*DeploymentTargetsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*DeploymentTargetsDataSource)(nil)
+)
+
+func NewDeploymentTargetsDataSource() datasource.DataSource {
+	return &DeploymentTargetsDataSource{}
+}
+
+// DeploymentTargetsDataSource defines the data source implementation.
+type DeploymentTargetsDataSource struct {
+	client *client.Client
+}
+
+// DeploymentTargetsDataSourceModel describes the data source data model.
+type DeploymentTargetsDataSourceModel struct {
+	SpaceID           types.String `tfsdk:"space_id"`
+	IDs               types.List   `tfsdk:"ids"`
+	PartialName       types.String `tfsdk:"partial_name"`
+	Roles             types.List   `tfsdk:"roles"`
+	EnvironmentIDs    types.List   `tfsdk:"environment_ids"`
+	HealthStatuses    types.List   `tfsdk:"health_statuses"`
+	DeploymentTargets types.List   `tfsdk:"deployment_targets"`
+}
+
+// DeploymentTargetDataSourceModel describes a deployment target in the data
+// source.
+type DeploymentTargetDataSourceModel struct {
+	SpaceID                         types.String `tfsdk:"space_id"`
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	CommunicationStyle              types.String `tfsdk:"communication_style"`
+	URI                             types.String `tfsdk:"uri"`
+	Thumbprint                      types.String `tfsdk:"thumbprint"`
+	EnvironmentIDs                  types.Set    `tfsdk:"environment_ids"`
+	Roles                           types.Set    `tfsdk:"roles"`
+	TenantedDeploymentParticipation types.String `tfsdk:"tenanted_deployment_participation"`
+	TenantIDs                       types.Set    `tfsdk:"tenant_ids"`
+	TenantTags                      types.Set    `tfsdk:"tenant_tags"`
+	MachinePolicyID                 types.String `tfsdk:"machine_policy_id"`
+	IsDisabled                      types.Bool   `tfsdk:"is_disabled"`
+	HealthStatus                    types.String `tfsdk:"health_status"`
+	StatusSummary                   types.String `tfsdk:"status_summary"`
+}
+
+// flattenDeploymentTargetDataSourceModel converts the deployment target to a
+// model.
+func flattenDeploymentTargetDataSourceModel(ctx context.Context, deploymentTarget *custom.DeploymentTarget) (*DeploymentTargetDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := DeploymentTargetDataSourceModel{
+		SpaceID:                         types.StringValue(deploymentTarget.SpaceID),
+		ID:                              types.StringValue(deploymentTarget.ID),
+		Name:                            types.StringValue(deploymentTarget.Name),
+		CommunicationStyle:              types.StringNull(),
+		URI:                             flattenOptionalString(deploymentTarget.URI),
+		Thumbprint:                      flattenOptionalString(deploymentTarget.Thumbprint),
+		TenantedDeploymentParticipation: types.StringValue(deploymentTarget.TenantedDeploymentParticipation),
+		MachinePolicyID:                 types.StringValue(deploymentTarget.MachinePolicyID),
+		IsDisabled:                      types.BoolValue(deploymentTarget.IsDisabled),
+		HealthStatus:                    types.StringValue(deploymentTarget.HealthStatus),
+		StatusSummary:                   types.StringValue(deploymentTarget.StatusSummary),
+	}
+
+	if deploymentTarget.Endpoint != nil {
+		model.CommunicationStyle = types.StringValue(deploymentTarget.Endpoint.CommunicationStyle)
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.EnvironmentIDs, nestedDiags = flattenStringSet(ctx, deploymentTarget.EnvironmentIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.Roles, nestedDiags = flattenStringSet(ctx, deploymentTarget.Roles)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.TenantIDs, nestedDiags = flattenStringSet(ctx, deploymentTarget.TenantIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.TenantTags, nestedDiags = flattenStringSet(ctx, deploymentTarget.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+func (d *DeploymentTargetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_deployment_targets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DeploymentTargetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *DeploymentTargetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	healthStatuses := []string{"Healthy", "HasWarnings", "Unhealthy", "Unavailable", "Unknown"}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the deployment targets in a space, such as the healthy targets with a role in an environment",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include deployment targets with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include deployment targets whose name contains this value",
+				Optional:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "Only include deployment targets with any of these roles",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"environment_ids": schema.ListAttribute{
+				MarkdownDescription: "Only include deployment targets in any of these environments",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"health_statuses": schema.ListAttribute{
+				MarkdownDescription: "Only include deployment targets with any of these health statuses, which are `Healthy`, `HasWarnings`, `Unhealthy`, `Unavailable` and `Unknown`",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.OneOf(healthStatuses...))},
+			},
+			"deployment_targets": schema.ListNestedAttribute{
+				MarkdownDescription: "List of deployment targets matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the space that the deployment target belongs to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the deployment target",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the deployment target",
+							Computed:            true,
+						},
+						"communication_style": schema.StringAttribute{
+							MarkdownDescription: "How Octopus communicates with the deployment target, such as `TentaclePassive`, `TentacleActive`, `Kubernetes`, `None` or `OfflineDrop`",
+							Computed:            true,
+						},
+						"uri": schema.StringAttribute{
+							MarkdownDescription: "The address of a Tentacle",
+							Computed:            true,
+						},
+						"thumbprint": schema.StringAttribute{
+							MarkdownDescription: "The thumbprint of a Tentacle's certificate",
+							Computed:            true,
+						},
+						"environment_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of the environments the deployment target belongs to",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"roles": schema.SetAttribute{
+							MarkdownDescription: "Roles of the deployment target",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tenanted_deployment_participation": schema.StringAttribute{
+							MarkdownDescription: "Whether the deployment target is used in tenanted deployments",
+							Computed:            true,
+						},
+						"tenant_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of the tenants the deployment target is used for",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tenant_tags": schema.SetAttribute{
+							MarkdownDescription: "Canonical names of the tenant tags whose tenants the deployment target is used for",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"machine_policy_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the machine policy of the deployment target",
+							Computed:            true,
+						},
+						"is_disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the deployment target is disabled",
+							Computed:            true,
+						},
+						"health_status": schema.StringAttribute{
+							MarkdownDescription: "The health of the deployment target at its last health check",
+							Computed:            true,
+						},
+						"status_summary": schema.StringAttribute{
+							MarkdownDescription: "A summary of the deployment target's last health check",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentTargetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data DeploymentTargetsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+
+	query := machines.MachinesQuery{
+		PartialName: data.PartialName.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	query.IDs, diags = expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query.Roles, diags = expandStringList(ctx, data.Roles)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query.EnvironmentIDs, diags = expandStringList(ctx, data.EnvironmentIDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query.HealthStatuses, diags = expandStringList(ctx, data.HealthStatuses)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetching deployment targets", map[string]interface{}{"space_id": spaceID, "query": query})
+
+	client := custom.NewClient(d.client, true)
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*custom.DeploymentTarget], error) {
+		query.Skip, query.Take = skip, take
+		return client.GetDeploymentTargets(ctx, spaceID, query)
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch deployment targets", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched deployment targets", map[string]interface{}{"count": len(items)})
+
+	models := []DeploymentTargetDataSourceModel{}
+	for _, item := range items {
+		model, diags := flattenDeploymentTargetDataSourceModel(ctx, item)
+		if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+			return
+		}
+
+		models = append(models, *model)
+	}
+
+	deploymentTargetSchema, ok := req.Config.Schema.GetAttributes()["deployment_targets"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for deployment_targets")
+		res.Diagnostics.AddError("Failed to fetch deployment targets", err.Error())
+		return
+	}
+
+	deploymentTargetList, diags := types.ListValueFrom(ctx, deploymentTargetSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.DeploymentTargets = deploymentTargetList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -28,13 +28,124 @@ func NewEnvironmentDataSource() datasource.DataSource {
 // EnvironmentDataSource defines the data source implementation.
 type EnvironmentDataSource struct {
 	client *client.Client
+	cache  *LookupCache
 }
 
 // EnvironmentDataSourceModel describes the data source data model.
 type EnvironmentDataSourceModel struct {
-	SpaceID types.String `tfsdk:"space_id"`
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
+	SpaceID                                types.String                                    `tfsdk:"space_id"`
+	ID                                     types.String                                    `tfsdk:"id"`
+	Name                                   types.String                                    `tfsdk:"name"`
+	Slug                                   types.String                                    `tfsdk:"slug"`
+	Description                            types.String                                    `tfsdk:"description"`
+	SortOrder                              types.Int64                                     `tfsdk:"sort_order"`
+	UseGuidedFailure                       types.Bool                                      `tfsdk:"use_guided_failure"`
+	AllowDynamicInfrastructure             types.Bool                                      `tfsdk:"allow_dynamic_infrastructure"`
+	JiraExtensionSettings                  *EnvironmentJiraExtensionSettingsModel          `tfsdk:"jira_extension_settings"`
+	JiraServiceManagementExtensionSettings *EnvironmentChangeControlExtensionSettingsModel `tfsdk:"jira_service_management_extension_settings"`
+	ServiceNowExtensionSettings            *EnvironmentChangeControlExtensionSettingsModel `tfsdk:"servicenow_extension_settings"`
+}
+
+// EnvironmentJiraExtensionSettingsModel describes the Jira extension settings
+// of an environment.
+type EnvironmentJiraExtensionSettingsModel struct {
+	EnvironmentType types.String `tfsdk:"environment_type"`
+}
+
+// EnvironmentChangeControlExtensionSettingsModel describes the settings of a
+// change controlled extension, such as ServiceNow, on an environment.
+type EnvironmentChangeControlExtensionSettingsModel struct {
+	IsEnabled types.Bool `tfsdk:"is_enabled"`
+}
+
+// flattenEnvironmentDataSourceModel converts the resource to a model.
+func flattenEnvironmentDataSourceModel(resource *environments.Environment) *EnvironmentDataSourceModel {
+	model := EnvironmentDataSourceModel{
+		SpaceID:                    types.StringValue(resource.SpaceID),
+		ID:                         types.StringValue(resource.ID),
+		Name:                       types.StringValue(resource.Name),
+		Slug:                       types.StringValue(resource.Slug),
+		Description:                types.StringValue(resource.Description),
+		SortOrder:                  types.Int64Value(int64(resource.SortOrder)),
+		UseGuidedFailure:           types.BoolValue(resource.UseGuidedFailure),
+		AllowDynamicInfrastructure: types.BoolValue(resource.AllowDynamicInfrastructure),
+	}
+
+	for _, settings := range resource.ExtensionSettings {
+		switch settings := settings.(type) {
+		case *environments.JiraExtensionSettings:
+			model.JiraExtensionSettings = &EnvironmentJiraExtensionSettingsModel{
+				EnvironmentType: types.StringValue(settings.JiraEnvironmentType),
+			}
+		case *environments.JiraServiceManagementExtensionSettings:
+			model.JiraServiceManagementExtensionSettings = &EnvironmentChangeControlExtensionSettingsModel{
+				IsEnabled: types.BoolValue(settings.IsChangeControlled()),
+			}
+		case *environments.ServiceNowExtensionSettings:
+			model.ServiceNowExtensionSettings = &EnvironmentChangeControlExtensionSettingsModel{
+				IsEnabled: types.BoolValue(settings.IsChangeControlled()),
+			}
+		}
+	}
+
+	return &model
+}
+
+// environmentDataSourceAttributes returns the computed attributes describing
+// an environment, shared by the environment and environments data sources.
+func environmentDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "A human-readable, unique identifier, used to identify an environment",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the environment",
+			Computed:            true,
+		},
+		"sort_order": schema.Int64Attribute{
+			MarkdownDescription: "The position of the environment relative to other environments",
+			Computed:            true,
+		},
+		"use_guided_failure": schema.BoolAttribute{
+			MarkdownDescription: "Whether deployments to the environment prompt for intervention when they fail",
+			Computed:            true,
+		},
+		"allow_dynamic_infrastructure": schema.BoolAttribute{
+			MarkdownDescription: "Whether deployment targets can be created in the environment during a deployment",
+			Computed:            true,
+		},
+		"jira_extension_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Jira integration settings for the environment, null when not configured",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"environment_type": schema.StringAttribute{
+					MarkdownDescription: "The Jira environment type, one of `unmapped`, `development`, `testing`, `staging` or `production`",
+					Computed:            true,
+				},
+			},
+		},
+		"jira_service_management_extension_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "Jira Service Management integration settings for the environment, null when not configured",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"is_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether deployments to the environment are change controlled",
+					Computed:            true,
+				},
+			},
+		},
+		"servicenow_extension_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "ServiceNow integration settings for the environment, null when not configured",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"is_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether deployments to the environment are change controlled",
+					Computed:            true,
+				},
+			},
+		},
+	}
 }
 
 func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
@@ -47,13 +158,14 @@ func (d *EnvironmentDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
+	d.cache = data.Cache
 }
 
 func (d *EnvironmentDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
@@ -64,25 +176,26 @@ func (d *EnvironmentDataSource) ConfigValidators(context.Context) []datasource.C
 }
 
 func (d *EnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	attributes := environmentDataSourceAttributes()
+	attributes["space_id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the space",
+		Computed:            true,
+		Optional:            true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the environment",
+		Computed:            true,
+		Optional:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the environment",
+		Computed:            true,
+		Optional:            true,
+	}
+
 	res.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to get the ID of an environment",
-		Attributes: map[string]schema.Attribute{
-			"space_id": schema.StringAttribute{
-				MarkdownDescription: "ID of the space",
-				Computed:            true,
-				Optional:            true,
-			},
-			"id": schema.StringAttribute{
-				MarkdownDescription: "ID of the environment",
-				Computed:            true,
-				Optional:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the environment",
-				Computed:            true,
-				Optional:            true,
-			},
-		},
+		MarkdownDescription: "Use this data source to get the ID, ordering and settings of an environment",
+		Attributes:          attributes,
 	}
 }
 
@@ -95,42 +208,30 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 	spaceID := data.SpaceID.ValueString()
 	name := data.Name.ValueString()
 	id := data.ID.ValueString()
-	query := environments.EnvironmentsQuery{
-		Name: name,
-		IDs:  []string{id},
-		Skip: 0,
-		Take: 1,
-	}
 
 	identifier := id
 	if name != "" {
 		identifier = name
 	}
 
-	tflog.Debug(ctx, "fetched environment", map[string]interface{}{"environment_identifier": identifier, "space_id": spaceID})
+	tflog.Debug(ctx, "fetching environment", map[string]interface{}{"environment_identifier": identifier, "space_id": spaceID})
 
-	resources, err := environments.Get(d.client, spaceID, query)
+	resource, err := d.cache.LookupEnvironment(ctx, d.client, spaceID, id, name)
 	if err != nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch environment %s", identifier), err.Error())
 		return
 	}
 
-	if len(resources.Items) < 1 {
+	if resource == nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch environment %s", identifier), "environment not found")
 		return
 	}
 
-	resource := resources.Items[0]
-
 	tflog.Debug(ctx, "fetched environment", map[string]interface{}{"environment": resource})
 
-	model := EnvironmentDataSourceModel{
-		SpaceID: types.StringValue(resource.SpaceID),
-		ID:      types.StringValue(resource.ID),
-		Name:    types.StringValue(resource.Name),
-	}
+	model := flattenEnvironmentDataSourceModel(resource)
 
-	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
 		return
 	}
 }
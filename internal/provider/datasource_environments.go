@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*EnvironmentsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*EnvironmentsDataSource)(nil)
+)
+
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &EnvironmentsDataSource{}
+}
+
+// EnvironmentsDataSource defines the data source implementation.
+type EnvironmentsDataSource struct {
+	client *client.Client
+}
+
+// EnvironmentsDataSourceModel describes the data source data model.
+type EnvironmentsDataSourceModel struct {
+	SpaceID      types.String `tfsdk:"space_id"`
+	IDs          types.List   `tfsdk:"ids"`
+	Names        types.List   `tfsdk:"names"`
+	PartialName  types.String `tfsdk:"partial_name"`
+	Environments types.List   `tfsdk:"environments"`
+}
+
+func (d *EnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_environments"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	environmentAttributes := environmentDataSourceAttributes()
+	environmentAttributes["space_id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the space that the environment belongs to",
+		Computed:            true,
+	}
+	environmentAttributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the environment",
+		Computed:            true,
+	}
+	environmentAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the environment",
+		Computed:            true,
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the environments in a space, ordered by their sort order",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include environments with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Only include environments with these names, compared case-insensitively",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include environments whose name contains this value",
+				Optional:            true,
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "List of environments matching the filters, ordered by `sort_order`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data EnvironmentsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+
+	ids, diags := expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	names, diags := expandStringList(ctx, data.Names)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query := environments.EnvironmentsQuery{
+		IDs:         ids,
+		PartialName: data.PartialName.ValueString(),
+	}
+
+	tflog.Debug(ctx, "fetching environments", map[string]interface{}{"space_id": spaceID, "query": query})
+
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*environments.Environment], error) {
+		query.Skip, query.Take = skip, take
+		return environments.Get(d.client, spaceID, query)
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch environments", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched environments", map[string]interface{}{"count": len(items)})
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].SortOrder < items[j].SortOrder
+	})
+
+	models := []EnvironmentDataSourceModel{}
+	for _, item := range items {
+		if len(names) > 0 && !slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, item.Name) }) {
+			continue
+		}
+
+		models = append(models, *flattenEnvironmentDataSourceModel(item))
+	}
+
+	environmentSchema, ok := req.Config.Schema.GetAttributes()["environments"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for environments")
+		res.Diagnostics.AddError("Failed to fetch environments", err.Error())
+		return
+	}
+
+	environmentList, diags := types.ListValueFrom(ctx, environmentSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Environments = environmentList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
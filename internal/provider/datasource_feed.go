@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = (*FeedDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*FeedDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*FeedDataSource)(nil)
+)
+
+func NewFeedDataSource() datasource.DataSource {
+	return &FeedDataSource{}
+}
+
+// FeedDataSource defines the data source implementation.
+type FeedDataSource struct {
+	client *client.Client
+}
+
+// FeedDataSourceModel describes the data source data model.
+type FeedDataSourceModel struct {
+	SpaceID                           types.String `tfsdk:"space_id"`
+	ID                                types.String `tfsdk:"id"`
+	Name                              types.String `tfsdk:"name"`
+	FeedType                          types.String `tfsdk:"feed_type"`
+	FeedURI                           types.String `tfsdk:"feed_uri"`
+	Username                          types.String `tfsdk:"username"`
+	PackageAcquisitionLocationOptions types.Set    `tfsdk:"package_acquisition_location_options"`
+	DownloadAttempts                  types.Int64  `tfsdk:"download_attempts"`
+	DownloadRetryBackoffSeconds       types.Int64  `tfsdk:"download_retry_backoff_seconds"`
+	APIVersion                        types.String `tfsdk:"api_version"`
+	RegistryPath                      types.String `tfsdk:"registry_path"`
+	Region                            types.String `tfsdk:"region"`
+	RoleARN                           types.String `tfsdk:"role_arn"`
+	DeleteUnreleasedPackagesAfterDays types.Int64  `tfsdk:"delete_unreleased_packages_after_days"`
+	IsBuiltInRepoSyncEnabled          types.Bool   `tfsdk:"is_built_in_repo_sync_enabled"`
+}
+
+// flattenFeedDataSourceModel converts the feed to a model. Settings which do
+// not apply to the type of feed are null.
+func flattenFeedDataSourceModel(ctx context.Context, feed *custom.Feed) (*FeedDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := FeedDataSourceModel{
+		SpaceID:                           types.StringValue(feed.SpaceID),
+		ID:                                types.StringValue(feed.ID),
+		Name:                              types.StringValue(feed.Name),
+		FeedType:                          types.StringValue(feed.FeedType),
+		FeedURI:                           flattenOptionalString(feed.FeedURI),
+		Username:                          flattenOptionalString(feed.Username),
+		DownloadAttempts:                  types.Int64Null(),
+		DownloadRetryBackoffSeconds:       types.Int64Null(),
+		APIVersion:                        flattenOptionalString(feed.APIVersion),
+		RegistryPath:                      flattenOptionalString(feed.RegistryPath),
+		Region:                            flattenOptionalString(feed.Region),
+		RoleARN:                           types.StringNull(),
+		DeleteUnreleasedPackagesAfterDays: types.Int64Null(),
+		IsBuiltInRepoSyncEnabled:          types.BoolNull(),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.PackageAcquisitionLocationOptions, nestedDiags = flattenStringSet(ctx, feed.PackageAcquisitionLocationOptions)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	switch feed.FeedType {
+	case string(feeds.FeedTypeBuiltIn):
+		model.DownloadAttempts = types.Int64Value(int64(feed.DownloadAttempts))
+		model.DownloadRetryBackoffSeconds = types.Int64Value(int64(feed.DownloadRetryBackoffSeconds))
+		model.DeleteUnreleasedPackagesAfterDays = types.Int64Value(int64(feed.DeleteUnreleasedPackagesAfterDays))
+		model.IsBuiltInRepoSyncEnabled = types.BoolValue(feed.IsBuiltInRepoSyncEnabled)
+
+	case string(feeds.FeedTypeNuGet), string(feeds.FeedTypeMaven), string(feeds.FeedTypeGitHub):
+		model.DownloadAttempts = types.Int64Value(int64(feed.DownloadAttempts))
+		model.DownloadRetryBackoffSeconds = types.Int64Value(int64(feed.DownloadRetryBackoffSeconds))
+
+	case string(feeds.FeedTypeAwsElasticContainerRegistry):
+		if feed.OIDCAuthentication != nil {
+			model.RoleARN = flattenOptionalString(feed.OIDCAuthentication.RoleARN)
+		}
+	}
+
+	return &model, diags
+}
+
+func (d *FeedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_feed"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FeedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *FeedDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *FeedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to retrieve a package feed by ID or name, including the built-in feed (`feeds-builtin`) and its retention settings",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the feed belongs to",
+				Computed:            true,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the feed",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the feed",
+				Computed:            true,
+				Optional:            true,
+			},
+			"feed_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the feed, such as `BuiltIn`, `NuGet` or `Docker`",
+				Computed:            true,
+			},
+			"feed_uri": schema.StringAttribute{
+				MarkdownDescription: "The URL of the feed",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to access the feed",
+				Computed:            true,
+			},
+			"package_acquisition_location_options": schema.SetAttribute{
+				MarkdownDescription: "Where packages from the feed can be acquired",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"download_attempts": schema.Int64Attribute{
+				MarkdownDescription: "The number of times a package download is attempted, for feed types which retry downloads",
+				Computed:            true,
+			},
+			"download_retry_backoff_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds to wait before retrying a failed package download, for feed types which retry downloads",
+				Computed:            true,
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "The API version of a Docker container registry",
+				Computed:            true,
+			},
+			"registry_path": schema.StringAttribute{
+				MarkdownDescription: "The host and path used to pull images from a Docker container registry",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region of an Elastic Container Registry",
+				Computed:            true,
+			},
+			"role_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the role assumed to access an Elastic Container Registry with OIDC",
+				Computed:            true,
+			},
+			"delete_unreleased_packages_after_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which packages not used by a release are deleted from the built-in feed",
+				Computed:            true,
+			},
+			"is_built_in_repo_sync_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether packages pushed to the built-in feed are synchronised to other Octopus nodes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FeedDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data FeedDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	id := data.ID.ValueString()
+	name := data.Name.ValueString()
+
+	identifier := id
+	if name != "" {
+		identifier = name
+	}
+
+	tflog.Debug(ctx, "fetching feed", map[string]interface{}{"feed_identifier": identifier, "space_id": spaceID})
+
+	client := custom.NewClient(d.client, true)
+
+	var feed *custom.Feed
+	if id != "" {
+		var err error
+		feed, err = client.GetFeed(ctx, spaceID, id)
+		if err != nil && !isAPIErrorNotFound(err) {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch feed %s", identifier), err.Error())
+			return
+		}
+	} else {
+		items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*custom.Feed], error) {
+			return client.GetFeeds(ctx, spaceID, feeds.FeedsQuery{PartialName: name, Skip: skip, Take: take})
+		})
+		if err != nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch feed %s", identifier), err.Error())
+			return
+		}
+
+		// partial name matches are narrowed to the exact name
+		for _, item := range items {
+			if item.Name == name {
+				feed = item
+				break
+			}
+		}
+	}
+
+	// a feed fetched by ID must also have the name when both are set
+	if feed == nil || (name != "" && feed.Name != name) {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch feed %s", identifier), "feed not found")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched feed", map[string]interface{}{"id": feed.ID, "feed_type": feed.FeedType})
+
+	model, diags := flattenFeedDataSourceModel(ctx, feed)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
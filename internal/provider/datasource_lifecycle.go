@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = (*LifecycleDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*LifecycleDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*LifecycleDataSource)(nil)
+)
+
+func NewLifecycleDataSource() datasource.DataSource {
+	return &LifecycleDataSource{}
+}
+
+// LifecycleDataSource defines the data source implementation.
+type LifecycleDataSource struct {
+	client *client.Client
+}
+
+// LifecycleDataSourceModel describes the data source data model.
+type LifecycleDataSourceModel struct {
+	SpaceID                 types.String                  `tfsdk:"space_id"`
+	ID                      types.String                  `tfsdk:"id"`
+	Name                    types.String                  `tfsdk:"name"`
+	Description             types.String                  `tfsdk:"description"`
+	ReleaseRetentionPolicy  *RetentionPolicyModel         `tfsdk:"release_retention_policy"`
+	TentacleRetentionPolicy *RetentionPolicyModel         `tfsdk:"tentacle_retention_policy"`
+	Phases                  []LifecyclePhaseResourceModel `tfsdk:"phases"`
+}
+
+// lookupLifecycle finds a lifecycle by ID, or by exact name when no ID is
+// given. It returns nil when no lifecycle matches.
+func lookupLifecycle(ctx context.Context, client *client.Client, spaceID, id, name string) (*lifecycles.Lifecycle, error) {
+	if id != "" {
+		lifecycle, err := lifecycles.GetByID(client, spaceID, id)
+		if isAPIErrorNotFound(err) {
+			return nil, nil
+		}
+
+		return lifecycle, err
+	}
+
+	all, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*lifecycles.Lifecycle], error) {
+		return lifecycles.Get(client, spaceID, lifecycles.Query{PartialName: name, Skip: skip, Take: take})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lifecycle := range all {
+		if strings.EqualFold(lifecycle.Name, name) {
+			return lifecycle, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// retentionPolicyDataSourceAttributes returns the attributes of a retention
+// policy.
+func retentionPolicyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"quantity_to_keep": schema.Int64Attribute{
+			MarkdownDescription: "The number of days or items to keep, ignored when kept forever",
+			Computed:            true,
+		},
+		"unit": schema.StringAttribute{
+			MarkdownDescription: "The unit of `quantity_to_keep`, either `Days` or `Items`",
+			Computed:            true,
+		},
+		"should_keep_forever": schema.BoolAttribute{
+			MarkdownDescription: "Whether everything is kept forever",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *LifecycleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_lifecycle"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *LifecycleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *LifecycleDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{datasourcevalidator.AtLeastOneOf(
+		path.MatchRoot("id"),
+		path.MatchRoot("name"),
+	)}
+}
+
+func (d *LifecycleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a lifecycle, its phases and retention policies",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the lifecycle",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the lifecycle",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the lifecycle",
+				Computed:            true,
+			},
+			"release_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How long releases are kept, applied to phases without their own policy",
+				Computed:            true,
+				Attributes:          retentionPolicyDataSourceAttributes(),
+			},
+			"tentacle_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How long extracted packages and files are kept on deployment targets, applied to phases without their own policy",
+				Computed:            true,
+				Attributes:          retentionPolicyDataSourceAttributes(),
+			},
+			"phases": schema.ListNestedAttribute{
+				MarkdownDescription: "The phases of the lifecycle, in promotion order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the phase",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the phase",
+							Computed:            true,
+						},
+						"automatic_deployment_targets": schema.SetAttribute{
+							MarkdownDescription: "IDs of environments which are deployed to automatically when the phase is reached",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"optional_deployment_targets": schema.SetAttribute{
+							MarkdownDescription: "IDs of environments which can be deployed to manually in the phase",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"minimum_environments_before_promotion": schema.Int64Attribute{
+							MarkdownDescription: "The number of environments which must be deployed to before the next phase, or `0` for all of them",
+							Computed:            true,
+						},
+						"is_optional_phase": schema.BoolAttribute{
+							MarkdownDescription: "Whether the phase can be skipped",
+							Computed:            true,
+						},
+						"release_retention_policy": schema.SingleNestedAttribute{
+							MarkdownDescription: "How long releases are kept in the phase, null when inherited from the lifecycle",
+							Computed:            true,
+							Attributes:          retentionPolicyDataSourceAttributes(),
+						},
+						"tentacle_retention_policy": schema.SingleNestedAttribute{
+							MarkdownDescription: "How long extracted packages and files are kept on deployment targets in the phase, null when inherited from the lifecycle",
+							Computed:            true,
+							Attributes:          retentionPolicyDataSourceAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LifecycleDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data LifecycleDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	name := data.Name.ValueString()
+	id := data.ID.ValueString()
+
+	identifier := id
+	if name != "" {
+		identifier = name
+	}
+
+	tflog.Debug(ctx, "fetching lifecycle", map[string]interface{}{"lifecycle_identifier": identifier, "space_id": spaceID})
+
+	resource, err := lookupLifecycle(ctx, d.client, spaceID, id, name)
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch lifecycle %s", identifier), err.Error())
+		return
+	}
+
+	if resource == nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch lifecycle %s", identifier), "lifecycle not found")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched lifecycle", map[string]interface{}{"lifecycle": resource})
+
+	lifecycle, diags := flattenLifecycleResourceModel(ctx, resource, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model := LifecycleDataSourceModel{
+		SpaceID:                 lifecycle.SpaceID,
+		ID:                      lifecycle.ID,
+		Name:                    lifecycle.Name,
+		Description:             lifecycle.Description,
+		ReleaseRetentionPolicy:  lifecycle.ReleaseRetentionPolicy,
+		TentacleRetentionPolicy: lifecycle.TentacleRetentionPolicy,
+		Phases:                  lifecycle.Phases,
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,14 +29,66 @@ func NewProjectDataSource() datasource.DataSource {
 // ProjectDataSource defines the data source implementation.
 type ProjectDataSource struct {
 	client *client.Client
+	cache  *LookupCache
 }
 
 // ProjectDataSourceModel describes the data source data model.
 type ProjectDataSourceModel struct {
-	SpaceID types.String `tfsdk:"space_id"`
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Slug    types.String `tfsdk:"slug"`
+	SpaceID                       types.String `tfsdk:"space_id"`
+	ID                            types.String `tfsdk:"id"`
+	Name                          types.String `tfsdk:"name"`
+	Slug                          types.String `tfsdk:"slug"`
+	Description                   types.String `tfsdk:"description"`
+	ProjectGroupID                types.String `tfsdk:"project_group_id"`
+	LifecycleID                   types.String `tfsdk:"lifecycle_id"`
+	IsDisabled                    types.Bool   `tfsdk:"is_disabled"`
+	TenantedDeploymentMode        types.String `tfsdk:"tenanted_deployment_mode"`
+	VariableSetID                 types.String `tfsdk:"variable_set_id"`
+	DeploymentProcessID           types.String `tfsdk:"deployment_process_id"`
+	IncludedLibraryVariableSetIDs types.List   `tfsdk:"included_library_variable_set_ids"`
+	DefaultChannelID              types.String `tfsdk:"default_channel_id"`
+	IsVersionControlled           types.Bool   `tfsdk:"is_version_controlled"`
+	GitRepositoryURL              types.String `tfsdk:"git_repository_url"`
+	GitDefaultBranch              types.String `tfsdk:"git_default_branch"`
+}
+
+// flattenProjectDataSourceModel converts the resource to a model.
+func flattenProjectDataSourceModel(ctx context.Context, resource *projects.Project, defaultChannelID string) (*ProjectDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ProjectDataSourceModel{
+		SpaceID:                types.StringValue(resource.SpaceID),
+		ID:                     types.StringValue(resource.ID),
+		Name:                   types.StringValue(resource.Name),
+		Slug:                   types.StringValue(resource.Slug),
+		Description:            types.StringValue(resource.Description),
+		ProjectGroupID:         types.StringValue(resource.ProjectGroupID),
+		LifecycleID:            types.StringValue(resource.LifecycleID),
+		IsDisabled:             types.BoolValue(resource.IsDisabled),
+		TenantedDeploymentMode: types.StringValue(string(resource.TenantedDeploymentMode)),
+		VariableSetID:          types.StringValue(resource.VariableSetID),
+		DeploymentProcessID:    types.StringValue(resource.DeploymentProcessID),
+		DefaultChannelID:       types.StringValue(defaultChannelID),
+		IsVersionControlled:    types.BoolValue(resource.IsVersionControlled),
+		GitRepositoryURL:       types.StringNull(),
+		GitDefaultBranch:       types.StringNull(),
+	}
+
+	if settings, ok := resource.PersistenceSettings.(projects.GitPersistenceSettings); ok {
+		if settings.URL() != nil {
+			model.GitRepositoryURL = types.StringValue(settings.URL().String())
+		}
+
+		model.GitDefaultBranch = types.StringValue(settings.DefaultBranch())
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.IncludedLibraryVariableSetIDs, nestedDiags = flattenStringList(ctx, resource.IncludedLibraryVariableSets)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	return &model, diags
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
@@ -48,25 +101,27 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
+	d.cache = data.Cache
 }
 
 func (d *ProjectDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{datasourcevalidator.AtLeastOneOf(
 		path.MatchRoot("id"),
 		path.MatchRoot("name"),
+		path.MatchRoot("slug"),
 	)}
 }
 
 func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
 	res.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to get the ID of a project",
+		MarkdownDescription: "Use this data source to get the ID and settings of a project",
 		Attributes: map[string]schema.Attribute{
 			"space_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the space that the project belongs to",
@@ -86,6 +141,56 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			"slug": schema.StringAttribute{
 				MarkdownDescription: "A human-readable, unique identifier, used to identify a project",
 				Computed:            true,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the project",
+				Computed:            true,
+			},
+			"project_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project group that the project belongs to",
+				Computed:            true,
+			},
+			"lifecycle_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the lifecycle used by the project",
+				Computed:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is disabled",
+				Computed:            true,
+			},
+			"tenanted_deployment_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the project deploys to tenants, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+				Computed:            true,
+			},
+			"variable_set_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the variable set owned by the project",
+				Computed:            true,
+			},
+			"deployment_process_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the deployment process owned by the project",
+				Computed:            true,
+			},
+			"included_library_variable_set_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the library variable sets included in the project",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the default channel of the project",
+				Computed:            true,
+			},
+			"is_version_controlled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is stored in a Git repository",
+				Computed:            true,
+			},
+			"git_repository_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the Git repository, when the project is version controlled",
+				Computed:            true,
+			},
+			"git_default_branch": schema.StringAttribute{
+				MarkdownDescription: "Default branch of the Git repository, when the project is version controlled",
+				Computed:            true,
 			},
 		},
 	}
@@ -100,42 +205,42 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	spaceID := data.SpaceID.ValueString()
 	name := data.Name.ValueString()
 	id := data.ID.ValueString()
-	query := projects.ProjectsQuery{
-		Name: name,
-		IDs:  []string{id},
-		Skip: 0,
-		Take: 1,
-	}
+	slug := data.Slug.ValueString()
 
 	identifier := id
 	if name != "" {
 		identifier = name
+	} else if slug != "" && id == "" {
+		identifier = slug
 	}
 
-	tflog.Debug(ctx, "fetched project", map[string]interface{}{"project_identifier": identifier, "space_id": spaceID})
+	tflog.Debug(ctx, "fetching project", map[string]interface{}{"project_identifier": identifier, "space_id": spaceID})
 
-	resources, err := projects.Get(d.client, spaceID, query)
+	resource, err := d.cache.LookupProject(ctx, d.client, spaceID, id, name, slug)
 	if err != nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch project %s", identifier), err.Error())
 		return
 	}
 
-	if len(resources.Items) < 1 {
+	if resource == nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch project %s", identifier), "project not found")
 		return
 	}
 
-	resource := resources.Items[0]
-
 	tflog.Debug(ctx, "fetched project", map[string]interface{}{"project": resource})
 
-	model := ProjectDataSourceModel{
-		SpaceID: types.StringValue(resource.SpaceID),
-		ID:      types.StringValue(resource.ID),
-		Name:    types.StringValue(resource.Name),
+	defaultChannelID, err := d.cache.LookupDefaultChannelID(ctx, d.client, resource)
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channels of project %s", identifier), err.Error())
+		return
+	}
+
+	model, diags := flattenProjectDataSourceModel(ctx, resource, defaultChannelID)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
 	}
 
-	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
 		return
 	}
 }
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*ProjectVersionControlDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*ProjectVersionControlDataSource)(nil)
+)
+
+func NewProjectVersionControlDataSource() datasource.DataSource {
+	return &ProjectVersionControlDataSource{}
+}
+
+// ProjectVersionControlDataSource defines the data source implementation.
+type ProjectVersionControlDataSource struct {
+	client *client.Client
+}
+
+// ProjectVersionControlDataSourceModel describes the data source data model.
+type ProjectVersionControlDataSourceModel struct {
+	SpaceID             types.String `tfsdk:"space_id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	IsVersionControlled types.Bool   `tfsdk:"is_version_controlled"`
+	URL                 types.String `tfsdk:"url"`
+	DefaultBranch       types.String `tfsdk:"default_branch"`
+	ProtectedBranches   types.Set    `tfsdk:"protected_branches"`
+	BasePath            types.String `tfsdk:"base_path"`
+	CredentialType      types.String `tfsdk:"credential_type"`
+	Username            types.String `tfsdk:"username"`
+	GitCredentialID     types.String `tfsdk:"git_credential_id"`
+	Branches            types.List   `tfsdk:"branches"`
+}
+
+// ProjectGitBranchModel describes a branch of the project's repository.
+type ProjectGitBranchModel struct {
+	Name          types.String `tfsdk:"name"`
+	CanonicalName types.String `tfsdk:"canonical_name"`
+	IsProtected   types.Bool   `tfsdk:"is_protected"`
+}
+
+// flattenProjectVersionControlDataSourceModel converts the git persistence
+// settings of the project to a model. Settings are null when the project is
+// stored in the database.
+func flattenProjectVersionControlDataSourceModel(ctx context.Context, project *projects.Project) (*ProjectVersionControlDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ProjectVersionControlDataSourceModel{
+		SpaceID:             types.StringValue(project.SpaceID),
+		ProjectID:           types.StringValue(project.ID),
+		IsVersionControlled: types.BoolValue(project.IsVersionControlled),
+		URL:                 types.StringNull(),
+		DefaultBranch:       types.StringNull(),
+		ProtectedBranches:   types.SetNull(types.StringType),
+		BasePath:            types.StringNull(),
+		CredentialType:      types.StringNull(),
+		Username:            types.StringNull(),
+		GitCredentialID:     types.StringNull(),
+	}
+
+	settings, ok := project.PersistenceSettings.(projects.GitPersistenceSettings)
+	if !ok {
+		return &model, diags
+	}
+
+	prior := ProjectVersionControlResourceModel{Password: types.StringNull()}
+	resourceModel, nestedDiags := flattenProjectVersionControlResourceModel(ctx, project, prior)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.URL = resourceModel.URL
+	model.DefaultBranch = resourceModel.DefaultBranch
+	model.ProtectedBranches = resourceModel.ProtectedBranches
+	model.BasePath = resourceModel.BasePath
+	model.Username = resourceModel.Username
+	model.GitCredentialID = resourceModel.GitCredentialID
+
+	model.CredentialType = types.StringValue(string(credentials.GitCredentialTypeAnonymous))
+	if settings.Credential() != nil {
+		model.CredentialType = types.StringValue(string(settings.Credential().Type()))
+	}
+
+	return &model, diags
+}
+
+func (d *ProjectVersionControlDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_project_version_control"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectVersionControlDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *ProjectVersionControlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to retrieve the Git settings and branches of a project",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the project belongs to",
+				Computed:            true,
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project",
+				Required:            true,
+			},
+			"is_version_controlled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is stored in a Git repository, the remaining attributes are null when it is not",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS URL of the Git repository",
+				Computed:            true,
+			},
+			"default_branch": schema.StringAttribute{
+				MarkdownDescription: "The default branch of the repository",
+				Computed:            true,
+			},
+			"protected_branches": schema.SetAttribute{
+				MarkdownDescription: "Patterns of branches which cannot be committed to from Octopus",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"base_path": schema.StringAttribute{
+				MarkdownDescription: "The directory in the repository holding the project's configuration",
+				Computed:            true,
+			},
+			"credential_type": schema.StringAttribute{
+				MarkdownDescription: "How the repository is accessed, one of `Anonymous`, `UsernamePassword` or `Reference`",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to access the repository, when the credential type is `UsernamePassword`",
+				Computed:            true,
+			},
+			"git_credential_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Git credential used to access the repository, when the credential type is `Reference`",
+				Computed:            true,
+			},
+			"branches": schema.ListNestedAttribute{
+				MarkdownDescription: "Branches of the repository, empty when the project is not version controlled",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the branch",
+							Computed:            true,
+						},
+						"canonical_name": schema.StringAttribute{
+							MarkdownDescription: "The fully qualified name of the branch, such as `refs/heads/main`",
+							Computed:            true,
+						},
+						"is_protected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the branch cannot be committed to from Octopus",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectVersionControlDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data ProjectVersionControlDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	projectID := data.ProjectID.ValueString()
+
+	tflog.Debug(ctx, "fetching project git settings", map[string]interface{}{"project_id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(d.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch project %s", projectID), "project not found")
+		return
+	}
+
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch project %s", projectID), err.Error())
+		return
+	}
+
+	model, diags := flattenProjectVersionControlDataSourceModel(ctx, project)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	branches := []*projects.GitReference{}
+	if project.IsVersionControlled {
+		branches, err = getAllPages(ctx, func(skip, take int) (*resources.Resources[*projects.GitReference], error) {
+			query := custom.GitBranchesQuery{Skip: skip, Take: take}
+			return custom.NewClient(d.client, true).GetProjectGitBranches(ctx, spaceID, projectID, query)
+		})
+		if err != nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch branches of project %s", projectID), err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "fetched project git settings", map[string]interface{}{"project_id": projectID, "branches": len(branches)})
+
+	models := []ProjectGitBranchModel{}
+	for _, branch := range branches {
+		models = append(models, ProjectGitBranchModel{
+			Name:          types.StringValue(branch.Name),
+			CanonicalName: types.StringValue(branch.CanonicalName),
+			IsProtected:   types.BoolValue(branch.IsProtected),
+		})
+	}
+
+	branchesSchema, ok := req.Config.Schema.GetAttributes()["branches"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for branches")
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch project %s", projectID), err.Error())
+		return
+	}
+
+	branchList, diags := types.ListValueFrom(ctx, branchesSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.Branches = branchList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*ProjectsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*ProjectsDataSource)(nil)
+)
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client *client.Client
+	cache  *LookupCache
+}
+
+// ProjectsDataSourceProjectModel describes the data source nested project data model.
+type ProjectsDataSourceProjectModel struct {
+	SpaceID                       types.String `tfsdk:"space_id"`
+	ID                            types.String `tfsdk:"id"`
+	Name                          types.String `tfsdk:"name"`
+	Slug                          types.String `tfsdk:"slug"`
+	Description                   types.String `tfsdk:"description"`
+	ProjectGroupID                types.String `tfsdk:"project_group_id"`
+	LifecycleID                   types.String `tfsdk:"lifecycle_id"`
+	IsDisabled                    types.Bool   `tfsdk:"is_disabled"`
+	TenantedDeploymentMode        types.String `tfsdk:"tenanted_deployment_mode"`
+	VariableSetID                 types.String `tfsdk:"variable_set_id"`
+	DeploymentProcessID           types.String `tfsdk:"deployment_process_id"`
+	IncludedLibraryVariableSetIDs types.List   `tfsdk:"included_library_variable_set_ids"`
+	IsVersionControlled           types.Bool   `tfsdk:"is_version_controlled"`
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	SpaceID        types.String `tfsdk:"space_id"`
+	IDs            types.List   `tfsdk:"ids"`
+	PartialName    types.String `tfsdk:"partial_name"`
+	ProjectGroupID types.String `tfsdk:"project_group_id"`
+	TenantID       types.String `tfsdk:"tenant_id"`
+	IsDisabled     types.Bool   `tfsdk:"is_disabled"`
+	Projects       types.List   `tfsdk:"projects"`
+}
+
+// flattenProjectsDataSourceProjectModel converts the resource to a nested model.
+func flattenProjectsDataSourceProjectModel(ctx context.Context, resource *projects.Project) (*ProjectsDataSourceProjectModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ProjectsDataSourceProjectModel{
+		SpaceID:                types.StringValue(resource.SpaceID),
+		ID:                     types.StringValue(resource.ID),
+		Name:                   types.StringValue(resource.Name),
+		Slug:                   types.StringValue(resource.Slug),
+		Description:            types.StringValue(resource.Description),
+		ProjectGroupID:         types.StringValue(resource.ProjectGroupID),
+		LifecycleID:            types.StringValue(resource.LifecycleID),
+		IsDisabled:             types.BoolValue(resource.IsDisabled),
+		TenantedDeploymentMode: types.StringValue(string(resource.TenantedDeploymentMode)),
+		VariableSetID:          types.StringValue(resource.VariableSetID),
+		DeploymentProcessID:    types.StringValue(resource.DeploymentProcessID),
+		IsVersionControlled:    types.BoolValue(resource.IsVersionControlled),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.IncludedLibraryVariableSetIDs, nestedDiags = flattenStringList(ctx, resource.IncludedLibraryVariableSets)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	return &model, diags
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+	d.cache = data.Cache
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the projects in a space, optionally filtered",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include projects with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include projects whose name contains this value",
+				Optional:            true,
+			},
+			"project_group_id": schema.StringAttribute{
+				MarkdownDescription: "Only include projects in this project group",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Only include projects connected to this tenant",
+				Optional:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Only include projects which are, or are not, disabled",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "List of projects matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the space that the project belongs to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the project",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "A human-readable, unique identifier, used to identify a project",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project",
+							Computed:            true,
+						},
+						"project_group_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the project group that the project belongs to",
+							Computed:            true,
+						},
+						"lifecycle_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the lifecycle used by the project",
+							Computed:            true,
+						},
+						"is_disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the project is disabled",
+							Computed:            true,
+						},
+						"tenanted_deployment_mode": schema.StringAttribute{
+							MarkdownDescription: "Whether the project deploys to tenants, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+							Computed:            true,
+						},
+						"variable_set_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the variable set owned by the project",
+							Computed:            true,
+						},
+						"deployment_process_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the deployment process owned by the project",
+							Computed:            true,
+						},
+						"included_library_variable_set_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the library variable sets included in the project",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"is_version_controlled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the project is stored in a Git repository",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	projectGroupID := data.ProjectGroupID.ValueString()
+	tenantID := data.TenantID.ValueString()
+
+	ids, diags := expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	// connected projects are only recorded against the tenant
+	var connectedProjects map[string][]string
+	if tenantID != "" {
+		tenant, err := d.cache.LookupTenant(ctx, d.client, spaceID, tenantID, "")
+		if err != nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", tenantID), err.Error())
+			return
+		}
+
+		if tenant == nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", tenantID), "tenant not found")
+			return
+		}
+
+		connectedProjects = tenant.ProjectEnvironments
+	}
+
+	query := projects.ProjectsQuery{
+		IDs:         ids,
+		PartialName: data.PartialName.ValueString(),
+	}
+
+	tflog.Debug(ctx, "fetching projects", map[string]interface{}{"space_id": spaceID, "query": query})
+
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*projects.Project], error) {
+		query.Skip, query.Take = skip, take
+		return projects.Get(d.client, spaceID, query)
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch projects", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched projects", map[string]interface{}{"count": len(items)})
+
+	models := []ProjectsDataSourceProjectModel{}
+	for _, item := range items {
+		if projectGroupID != "" && item.ProjectGroupID != projectGroupID {
+			continue
+		}
+
+		if !data.IsDisabled.IsNull() && item.IsDisabled != data.IsDisabled.ValueBool() {
+			continue
+		}
+
+		if tenantID != "" {
+			if _, ok := connectedProjects[item.ID]; !ok {
+				continue
+			}
+		}
+
+		model, diags := flattenProjectsDataSourceProjectModel(ctx, item)
+		if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+			return
+		}
+
+		models = append(models, *model)
+	}
+
+	projectSchema, ok := req.Config.Schema.GetAttributes()["projects"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for projects")
+		res.Diagnostics.AddError("Failed to fetch projects", err.Error())
+		return
+	}
+
+	projectList, diags := types.ListValueFrom(ctx, projectSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Projects = projectList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = (*RunbookDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*RunbookDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*RunbookDataSource)(nil)
+)
+
+func NewRunbookDataSource() datasource.DataSource {
+	return &RunbookDataSource{}
+}
+
+// RunbookDataSource defines the data source implementation.
+type RunbookDataSource struct {
+	client *client.Client
+}
+
+// RunbookDataSourceModel describes the data source data model.
+type RunbookDataSourceModel struct {
+	SpaceID                    types.String                 `tfsdk:"space_id"`
+	ID                         types.String                 `tfsdk:"id"`
+	ProjectID                  types.String                 `tfsdk:"project_id"`
+	Name                       types.String                 `tfsdk:"name"`
+	Description                types.String                 `tfsdk:"description"`
+	EnvironmentScope           types.String                 `tfsdk:"environment_scope"`
+	Environments               types.Set                    `tfsdk:"environments"`
+	MultiTenancyMode           types.String                 `tfsdk:"multi_tenancy_mode"`
+	ConnectivityPolicy         *ConnectivityPolicyModel     `tfsdk:"connectivity_policy"`
+	RunRetentionPolicy         *RunbookRetentionPolicyModel `tfsdk:"run_retention_policy"`
+	DefaultGuidedFailureMode   types.String                 `tfsdk:"default_guided_failure_mode"`
+	RunbookProcessID           types.String                 `tfsdk:"runbook_process_id"`
+	PublishedRunbookSnapshotID types.String                 `tfsdk:"published_runbook_snapshot_id"`
+}
+
+func (d *RunbookDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_runbook"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RunbookDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *RunbookDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		datasourcevalidator.RequiredTogether(path.MatchRoot("project_id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *RunbookDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a runbook by ID, or by its project and name",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the runbook",
+				Computed:            true,
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project that the runbook belongs to, required with `name`",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the runbook",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the runbook",
+				Computed:            true,
+			},
+			"environment_scope": schema.StringAttribute{
+				MarkdownDescription: "Which environments the runbook can run in, one of `All`, `FromProjectLifecycles` or `Specified`",
+				Computed:            true,
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "IDs of the environments the runbook can run in, when `environment_scope` is `Specified`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"multi_tenancy_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the runbook runs for tenants, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+				Computed:            true,
+			},
+			"connectivity_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How deployment targets which are unavailable or unhealthy are treated",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"allow_deployments_to_no_targets": schema.BoolAttribute{
+						MarkdownDescription: "Whether to continue when there are no deployment targets",
+						Computed:            true,
+					},
+					"exclude_unhealthy_targets": schema.BoolAttribute{
+						MarkdownDescription: "Whether to leave out deployment targets which are unhealthy",
+						Computed:            true,
+					},
+					"skip_machine_behavior": schema.StringAttribute{
+						MarkdownDescription: "Either `None`, to fail when a deployment target is unavailable, or `SkipUnavailableMachines`",
+						Computed:            true,
+					},
+					"target_roles": schema.SetAttribute{
+						MarkdownDescription: "Roles of the deployment targets which can be skipped when unavailable",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"run_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How many runs of the runbook are kept in each environment",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"quantity_to_keep": schema.Int64Attribute{
+						MarkdownDescription: "The number of runs to keep, ignored when kept forever",
+						Computed:            true,
+					},
+					"should_keep_forever": schema.BoolAttribute{
+						MarkdownDescription: "Whether every run is kept forever",
+						Computed:            true,
+					},
+				},
+			},
+			"default_guided_failure_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether runs prompt for intervention when they fail, one of `EnvironmentDefault`, `Off` or `On`",
+				Computed:            true,
+			},
+			"runbook_process_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the process holding the runbook's steps",
+				Computed:            true,
+			},
+			"published_runbook_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the published snapshot of the runbook, null until a snapshot is published",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RunbookDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data RunbookDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	projectID := data.ProjectID.ValueString()
+	name := data.Name.ValueString()
+	id := data.ID.ValueString()
+
+	identifier := id
+	if name != "" {
+		identifier = name
+	}
+
+	tflog.Debug(ctx, "fetching runbook", map[string]interface{}{"runbook_identifier": identifier, "project_id": projectID, "space_id": spaceID})
+
+	var resource *runbooks.Runbook
+	var err error
+	if id != "" {
+		resource, err = runbooks.GetByID(d.client, spaceID, id)
+		if isAPIErrorNotFound(err) {
+			resource, err = nil, nil
+		}
+	} else {
+		resource, err = runbooks.GetByName(d.client, spaceID, projectID, name)
+	}
+
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch runbook %s", identifier), err.Error())
+		return
+	}
+
+	if resource == nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch runbook %s", identifier), "runbook not found")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched runbook", map[string]interface{}{"runbook": resource})
+
+	runbook, diags := flattenRunbookResourceModel(ctx, resource)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model := RunbookDataSourceModel{
+		SpaceID:                    runbook.SpaceID,
+		ID:                         runbook.ID,
+		ProjectID:                  runbook.ProjectID,
+		Name:                       runbook.Name,
+		Description:                runbook.Description,
+		EnvironmentScope:           runbook.EnvironmentScope,
+		Environments:               runbook.Environments,
+		MultiTenancyMode:           runbook.MultiTenancyMode,
+		ConnectivityPolicy:         runbook.ConnectivityPolicy,
+		RunRetentionPolicy:         runbook.RunRetentionPolicy,
+		DefaultGuidedFailureMode:   runbook.DefaultGuidedFailureMode,
+		RunbookProcessID:           runbook.RunbookProcessID,
+		PublishedRunbookSnapshotID: runbook.PublishedRunbookSnapshotID,
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
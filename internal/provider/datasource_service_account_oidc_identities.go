@@ -54,13 +54,13 @@ func (d *ServiceAccountOIDCIdentities) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 func (d *ServiceAccountOIDCIdentities) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
@@ -129,7 +129,7 @@ func (d *ServiceAccountOIDCIdentities) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	identities, err := custom.NewClient(d.client).ListServiceAccountOIDCIdentites(ctx, id, int(skip), int(take))
+	identities, err := custom.NewClient(d.client, true).ListServiceAccountOIDCIdentites(ctx, id, int(skip), int(take))
 	if err != nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch service account oidc identities %s", id), err.Error())
 		return
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = (*TagSetDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*TagSetDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*TagSetDataSource)(nil)
+)
+
+func NewTagSetDataSource() datasource.DataSource {
+	return &TagSetDataSource{}
+}
+
+// TagSetDataSource defines the data source implementation.
+type TagSetDataSource struct {
+	client *client.Client
+}
+
+// TagSetDataSourceModel describes the data source data model.
+type TagSetDataSourceModel struct {
+	SpaceID           types.String             `tfsdk:"space_id"`
+	ID                types.String             `tfsdk:"id"`
+	Name              types.String             `tfsdk:"name"`
+	Description       types.String             `tfsdk:"description"`
+	Tags              []TagSetTagResourceModel `tfsdk:"tags"`
+	CanonicalTagNames types.List               `tfsdk:"canonical_tag_names"`
+}
+
+// flattenTagSetDataSourceModel converts the resource to a model.
+func flattenTagSetDataSourceModel(ctx context.Context, resource *tagsets.TagSet) (*TagSetDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagSet := flattenTagSetResourceModel(resource)
+	model := TagSetDataSourceModel{
+		SpaceID:     tagSet.SpaceID,
+		ID:          tagSet.ID,
+		Name:        tagSet.Name,
+		Description: tagSet.Description,
+		Tags:        tagSet.Tags,
+	}
+
+	canonicalTagNames := []string{}
+	for _, tag := range tagSet.Tags {
+		canonicalTagNames = append(canonicalTagNames, tag.CanonicalName.ValueString())
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.CanonicalTagNames, nestedDiags = types.ListValueFrom(ctx, types.StringType, canonicalTagNames)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// lookupTagSet finds a tag set by ID, or by exact name when no ID is given.
+// It returns nil when no tag set matches.
+func lookupTagSet(ctx context.Context, client *client.Client, spaceID, id, name string) (*tagsets.TagSet, error) {
+	if id != "" {
+		tagSet, err := tagsets.GetByID(client, spaceID, id)
+		if isAPIErrorNotFound(err) {
+			return nil, nil
+		}
+
+		return tagSet, err
+	}
+
+	tagSets, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*tagsets.TagSet], error) {
+		return tagsets.Get(client, spaceID, tagsets.TagSetsQuery{PartialName: name, Skip: skip, Take: take})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tagSet := range tagSets {
+		if strings.EqualFold(tagSet.Name, name) {
+			return tagSet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (d *TagSetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tag_set"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TagSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *TagSetDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{datasourcevalidator.AtLeastOneOf(
+		path.MatchRoot("id"),
+		path.MatchRoot("name"),
+	)}
+}
+
+func (d *TagSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a tag set and the canonical names of its tags",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the tag set",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag set",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the tag set",
+				Computed:            true,
+			},
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "The tags of the tag set, in display order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the tag",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the tag",
+							Computed:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "The color of the tag, in the form `#RRGGBB`",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the tag",
+							Computed:            true,
+						},
+						"canonical_name": schema.StringAttribute{
+							MarkdownDescription: "The canonical name of the tag, in the form `TagSet/Tag`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"canonical_tag_names": schema.ListAttribute{
+				MarkdownDescription: "The canonical names of all tags in the tag set, in display order",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *TagSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data TagSetDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	name := data.Name.ValueString()
+	id := data.ID.ValueString()
+
+	identifier := id
+	if name != "" {
+		identifier = name
+	}
+
+	tflog.Debug(ctx, "fetching tag set", map[string]interface{}{"tag_set_identifier": identifier, "space_id": spaceID})
+
+	resource, err := lookupTagSet(ctx, d.client, spaceID, id, name)
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tag set %s", identifier), err.Error())
+		return
+	}
+
+	if resource == nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tag set %s", identifier), "tag set not found")
+		return
+	}
+
+	tflog.Debug(ctx, "fetched tag set", map[string]interface{}{"tag_set": resource})
+
+	model, diags := flattenTagSetDataSourceModel(ctx, resource)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,13 +30,51 @@ func NewTenantDataSource() datasource.DataSource {
 // TenantDataSource defines the data source implementation.
 type TenantDataSource struct {
 	client *client.Client
+	cache  *LookupCache
 }
 
 // TenantDataSourceModel describes the data source data model.
 type TenantDataSourceModel struct {
-	SpaceID types.String `tfsdk:"space_id"`
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
+	SpaceID             types.String `tfsdk:"space_id"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Tags                types.List   `tfsdk:"tags"`
+	Description         types.String `tfsdk:"description"`
+	ClonedFromTenantID  types.String `tfsdk:"cloned_from_tenant_id"`
+	TenantTags          types.List   `tfsdk:"tenant_tags"`
+	ProjectEnvironments types.Map    `tfsdk:"project_environments"`
+}
+
+// flattenTenantDataSourceModel converts the resource to a model.
+func flattenTenantDataSourceModel(ctx context.Context, resource *tenants.Tenant, tags types.List) (*TenantDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := TenantDataSourceModel{
+		SpaceID:            types.StringValue(resource.SpaceID),
+		ID:                 types.StringValue(resource.ID),
+		Name:               types.StringValue(resource.Name),
+		Tags:               tags,
+		Description:        types.StringValue(resource.Description),
+		ClonedFromTenantID: types.StringValue(resource.ClonedFromTenantID),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.TenantTags, nestedDiags = flattenStringList(ctx, resource.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	projectEnvironments := resource.ProjectEnvironments
+	if projectEnvironments == nil {
+		projectEnvironments = map[string][]string{}
+	}
+
+	model.ProjectEnvironments, nestedDiags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, projectEnvironments)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	return &model, diags
 }
 
 func (d *TenantDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
@@ -47,25 +87,27 @@ func (d *TenantDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
+	d.cache = data.Cache
 }
 
 func (d *TenantDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{datasourcevalidator.AtLeastOneOf(
 		path.MatchRoot("id"),
 		path.MatchRoot("name"),
+		path.MatchRoot("tags"),
 	)}
 }
 
 func (d *TenantDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
 	res.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to get the ID of a tenant",
+		MarkdownDescription: "Use this data source to get the ID, tags and project connections of a tenant",
 		Attributes: map[string]schema.Attribute{
 			"space_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the space",
@@ -82,6 +124,29 @@ func (d *TenantDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed:            true,
 				Optional:            true,
 			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Canonical names of tags, in the form `TagSet/Tag`, that the tenant must have. When used without `id` or `name`, exactly one tenant must have all of the tags",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the tenant",
+				Computed:            true,
+			},
+			"cloned_from_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the tenant that this tenant was cloned from",
+				Computed:            true,
+			},
+			"tenant_tags": schema.ListAttribute{
+				MarkdownDescription: "Canonical names of the tags applied to the tenant",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"project_environments": schema.MapAttribute{
+				MarkdownDescription: "IDs of the environments the tenant is connected to, keyed by project ID",
+				Computed:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+			},
 		},
 	}
 }
@@ -95,42 +160,64 @@ func (d *TenantDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	spaceID := data.SpaceID.ValueString()
 	name := data.Name.ValueString()
 	id := data.ID.ValueString()
-	query := tenants.TenantsQuery{
-		Name: name,
-		IDs:  []string{id},
-		Skip: 0,
-		Take: 1,
+
+	tags, diags := expandStringList(ctx, data.Tags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
 	}
 
 	identifier := id
 	if name != "" {
 		identifier = name
+	} else if id == "" {
+		identifier = strings.Join(tags, ", ")
 	}
 
-	tflog.Debug(ctx, "fetched tenant", map[string]interface{}{"tenant_identifier": identifier, "space_id": spaceID})
-
-	tenants, err := tenants.Get(d.client, spaceID, query)
-	if err != nil {
-		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", identifier), err.Error())
-		return
+	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"tenant_identifier": identifier, "space_id": spaceID})
+
+	var tenant *tenants.Tenant
+	if id == "" && name == "" {
+		matches, err := d.cache.LookupTenantsByTags(ctx, d.client, spaceID, tags)
+		if err != nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", identifier), err.Error())
+			return
+		}
+
+		if len(matches) > 1 {
+			err := fmt.Errorf("%d tenants have all of the given tags, add more tags or specify a name", len(matches))
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", identifier), err.Error())
+			return
+		}
+
+		if len(matches) == 1 {
+			tenant = matches[0]
+		}
+	} else {
+		var err error
+		tenant, err = d.cache.LookupTenant(ctx, d.client, spaceID, id, name)
+		if err != nil {
+			res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", identifier), err.Error())
+			return
+		}
+
+		if tenant != nil && !hasAllTags(tenant.TenantTags, tags) {
+			tenant = nil
+		}
 	}
 
-	if len(tenants.Items) < 1 {
+	if tenant == nil {
 		res.Diagnostics.AddError(fmt.Sprintf("Failed to fetch tenant %s", identifier), "tenant not found")
 		return
 	}
 
-	tenant := tenants.Items[0]
-
 	tflog.Debug(ctx, "fetched tenant", map[string]interface{}{"tenant": tenant})
 
-	model := TenantDataSourceModel{
-		SpaceID: types.StringValue(tenant.SpaceID),
-		ID:      types.StringValue(tenant.ID),
-		Name:    types.StringValue(tenant.Name),
+	model, diags := flattenTenantDataSourceModel(ctx, tenant, data.Tags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
 	}
 
-	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
 		return
 	}
 }
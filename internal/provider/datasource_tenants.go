@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*TenantsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*TenantsDataSource)(nil)
+)
+
+func NewTenantsDataSource() datasource.DataSource {
+	return &TenantsDataSource{}
+}
+
+// TenantsDataSource defines the data source implementation.
+type TenantsDataSource struct {
+	client *client.Client
+}
+
+// TenantsDataSourceTenantModel describes the data source nested tenant data model.
+type TenantsDataSourceTenantModel struct {
+	SpaceID             types.String `tfsdk:"space_id"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ClonedFromTenantID  types.String `tfsdk:"cloned_from_tenant_id"`
+	TenantTags          types.List   `tfsdk:"tenant_tags"`
+	ProjectEnvironments types.Map    `tfsdk:"project_environments"`
+}
+
+// TenantsDataSourceModel describes the data source data model.
+type TenantsDataSourceModel struct {
+	SpaceID            types.String `tfsdk:"space_id"`
+	IDs                types.List   `tfsdk:"ids"`
+	PartialName        types.String `tfsdk:"partial_name"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	ClonedFromTenantID types.String `tfsdk:"cloned_from_tenant_id"`
+	AllTags            types.List   `tfsdk:"all_tags"`
+	AnyTags            types.List   `tfsdk:"any_tags"`
+	Tenants            types.List   `tfsdk:"tenants"`
+}
+
+// flattenTenantsDataSourceTenantModel converts the resource to a nested model.
+func flattenTenantsDataSourceTenantModel(ctx context.Context, resource *tenants.Tenant) (*TenantsDataSourceTenantModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := TenantsDataSourceTenantModel{
+		SpaceID:            types.StringValue(resource.SpaceID),
+		ID:                 types.StringValue(resource.ID),
+		Name:               types.StringValue(resource.Name),
+		Description:        types.StringValue(resource.Description),
+		ClonedFromTenantID: types.StringValue(resource.ClonedFromTenantID),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.TenantTags, nestedDiags = flattenStringList(ctx, resource.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	projectEnvironments := resource.ProjectEnvironments
+	if projectEnvironments == nil {
+		projectEnvironments = map[string][]string{}
+	}
+
+	model.ProjectEnvironments, nestedDiags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, projectEnvironments)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return &model, diags
+	}
+
+	return &model, diags
+}
+
+func (d *TenantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tenants"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TenantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *TenantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the tenants in a space, optionally filtered by tags, connected project, name or the tenant they were cloned from",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include tenants with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include tenants whose name contains this value",
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Only include tenants connected to this project",
+				Optional:            true,
+			},
+			"cloned_from_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Only include tenants cloned from this tenant",
+				Optional:            true,
+			},
+			"all_tags": schema.ListAttribute{
+				MarkdownDescription: "Only include tenants which have all of these tags, as canonical names in the form `TagSet/Tag`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"any_tags": schema.ListAttribute{
+				MarkdownDescription: "Only include tenants which have at least one of these tags, as canonical names in the form `TagSet/Tag`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tenants": schema.ListNestedAttribute{
+				MarkdownDescription: "List of tenants matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the space that the tenant belongs to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the tenant",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the tenant",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the tenant",
+							Computed:            true,
+						},
+						"cloned_from_tenant_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the tenant that this tenant was cloned from",
+							Computed:            true,
+						},
+						"tenant_tags": schema.ListAttribute{
+							MarkdownDescription: "Canonical names of the tags applied to the tenant",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"project_environments": schema.MapAttribute{
+							MarkdownDescription: "IDs of the environments the tenant is connected to, keyed by project ID",
+							Computed:            true,
+							ElementType:         types.SetType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TenantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data TenantsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+
+	ids, diags := expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	allTags, diags := expandStringList(ctx, data.AllTags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	anyTags, diags := expandStringList(ctx, data.AnyTags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	// tags are matched locally as the server ors tags within the same tag set
+	query := tenants.TenantsQuery{
+		IDs:                ids,
+		PartialName:        data.PartialName.ValueString(),
+		ProjectID:          data.ProjectID.ValueString(),
+		ClonedFromTenantID: data.ClonedFromTenantID.ValueString(),
+	}
+
+	tflog.Debug(ctx, "fetching tenants", map[string]interface{}{"space_id": spaceID, "query": query})
+
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[*tenants.Tenant], error) {
+		query.Skip, query.Take = skip, take
+		return tenants.Get(d.client, spaceID, query)
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch tenants", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched tenants", map[string]interface{}{"count": len(items)})
+
+	models := []TenantsDataSourceTenantModel{}
+	for _, item := range items {
+		if !hasAllTags(item.TenantTags, allTags) {
+			continue
+		}
+
+		if len(anyTags) > 0 && !hasAnyTag(item.TenantTags, anyTags) {
+			continue
+		}
+
+		model, diags := flattenTenantsDataSourceTenantModel(ctx, item)
+		if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+			return
+		}
+
+		models = append(models, *model)
+	}
+
+	tenantSchema, ok := req.Config.Schema.GetAttributes()["tenants"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for tenants")
+		res.Diagnostics.AddError("Failed to fetch tenants", err.Error())
+		return
+	}
+
+	tenantList, diags := types.ListValueFrom(ctx, tenantSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Tenants = tenantList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
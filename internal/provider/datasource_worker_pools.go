@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = (*WorkerPoolsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*WorkerPoolsDataSource)(nil)
+)
+
+func NewWorkerPoolsDataSource() datasource.DataSource {
+	return &WorkerPoolsDataSource{}
+}
+
+// WorkerPoolsDataSource defines the data source implementation.
+type WorkerPoolsDataSource struct {
+	client *client.Client
+}
+
+// WorkerPoolsDataSourceModel describes the data source data model.
+type WorkerPoolsDataSourceModel struct {
+	SpaceID        types.String `tfsdk:"space_id"`
+	IDs            types.List   `tfsdk:"ids"`
+	Names          types.List   `tfsdk:"names"`
+	PartialName    types.String `tfsdk:"partial_name"`
+	WorkerPoolType types.String `tfsdk:"worker_pool_type"`
+	WorkerPools    types.List   `tfsdk:"worker_pools"`
+}
+
+// WorkerPoolDataSourceModel describes a worker pool in the data source.
+type WorkerPoolDataSourceModel struct {
+	SpaceID        types.String `tfsdk:"space_id"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	WorkerPoolType types.String `tfsdk:"worker_pool_type"`
+	WorkerType     types.String `tfsdk:"worker_type"`
+	IsDefault      types.Bool   `tfsdk:"is_default"`
+	SortOrder      types.Int64  `tfsdk:"sort_order"`
+	CanAddWorkers  types.Bool   `tfsdk:"can_add_workers"`
+}
+
+// flattenWorkerPoolDataSourceModel converts the worker pool to a model. The
+// worker type is null for static worker pools.
+func flattenWorkerPoolDataSourceModel(workerPool workerpools.IWorkerPool) *WorkerPoolDataSourceModel {
+	model := WorkerPoolDataSourceModel{
+		SpaceID:        types.StringValue(workerPool.GetSpaceID()),
+		ID:             types.StringValue(workerPool.GetID()),
+		Name:           types.StringValue(workerPool.GetName()),
+		Description:    types.StringValue(workerPool.GetDescription()),
+		WorkerPoolType: types.StringValue(string(workerPool.GetWorkerPoolType())),
+		WorkerType:     types.StringNull(),
+		IsDefault:      types.BoolValue(workerPool.GetIsDefault()),
+		SortOrder:      types.Int64Value(int64(workerPool.GetSortOrder())),
+		CanAddWorkers:  types.BoolValue(workerPool.GetCanAddWorkers()),
+	}
+
+	if dynamicWorkerPool, ok := workerPool.(*workerpools.DynamicWorkerPool); ok {
+		model.WorkerType = types.StringValue(dynamicWorkerPool.GetWorkerType())
+	}
+
+	return &model
+}
+
+func (d *WorkerPoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_worker_pools"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *WorkerPoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedDataSourceConfigureType(req.ProviderData))
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *WorkerPoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	workerPoolTypes := []string{string(workerpools.WorkerPoolTypeStatic), string(workerpools.WorkerPoolTypeDynamic)}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the worker pools in a space, ordered by their sort order, such as to look up the `worker_pool_id` of a deployment process step",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space",
+				Computed:            true,
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Only include worker pools with these IDs",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Only include worker pools with these names, compared case-insensitively",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"partial_name": schema.StringAttribute{
+				MarkdownDescription: "Only include worker pools whose name contains this value",
+				Optional:            true,
+			},
+			"worker_pool_type": schema.StringAttribute{
+				MarkdownDescription: "Only include worker pools of this type, either `StaticWorkerPool` or `DynamicWorkerPool`",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(workerPoolTypes...)},
+			},
+			"worker_pools": schema.ListNestedAttribute{
+				MarkdownDescription: "List of worker pools matching the filters, ordered by `sort_order`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the space that the worker pool belongs to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the worker pool",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the worker pool",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the worker pool",
+							Computed:            true,
+						},
+						"worker_pool_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the worker pool, either `StaticWorkerPool` or `DynamicWorkerPool`",
+							Computed:            true,
+						},
+						"worker_type": schema.StringAttribute{
+							MarkdownDescription: "The type of worker leased by a dynamic worker pool",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the worker pool is the default for the space",
+							Computed:            true,
+						},
+						"sort_order": schema.Int64Attribute{
+							MarkdownDescription: "The order of the worker pool in the space",
+							Computed:            true,
+						},
+						"can_add_workers": schema.BoolAttribute{
+							MarkdownDescription: "Whether workers can be added to the worker pool",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkerPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var data WorkerPoolsDataSourceModel
+	if res.Diagnostics.Append(req.Config.Get(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(d.client, data.SpaceID.ValueString())
+	workerPoolType := data.WorkerPoolType.ValueString()
+
+	ids, diags := expandStringList(ctx, data.IDs)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	names, diags := expandStringList(ctx, data.Names)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	query := workerpools.WorkerPoolsQuery{
+		IDs:         ids,
+		PartialName: data.PartialName.ValueString(),
+	}
+
+	tflog.Debug(ctx, "fetching worker pools", map[string]interface{}{"space_id": spaceID, "query": query})
+
+	items, err := getAllPages(ctx, func(skip, take int) (*resources.Resources[workerpools.IWorkerPool], error) {
+		query.Skip, query.Take = skip, take
+		page, err := workerpools.Get(d.client, spaceID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return &resources.Resources[workerpools.IWorkerPool]{Items: page.Items, PagedResults: page.PagedResults}, nil
+	})
+	if err != nil {
+		res.Diagnostics.AddError("Failed to fetch worker pools", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "fetched worker pools", map[string]interface{}{"count": len(items)})
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].GetSortOrder() < items[j].GetSortOrder()
+	})
+
+	models := []WorkerPoolDataSourceModel{}
+	for _, item := range items {
+		if len(names) > 0 && !slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, item.GetName()) }) {
+			continue
+		}
+
+		if workerPoolType != "" && string(item.GetWorkerPoolType()) != workerPoolType {
+			continue
+		}
+
+		models = append(models, *flattenWorkerPoolDataSourceModel(item))
+	}
+
+	workerPoolSchema, ok := req.Config.Schema.GetAttributes()["worker_pools"].(schema.ListNestedAttribute)
+	if !ok {
+		err := fmt.Errorf("found invalid schema type for worker_pools")
+		res.Diagnostics.AddError("Failed to fetch worker pools", err.Error())
+		return
+	}
+
+	workerPoolList, diags := types.ListValueFrom(ctx, workerPoolSchema.NestedObject.Type(), models)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.WorkerPools = workerPoolList
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &data)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -42,6 +42,16 @@ func ErrMissingAttribute(attributeName string) diag.Diagnostic {
 	)
 }
 
+func ErrReadOnlyProvider(operation string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Provider is read-only",
+		fmt.Sprintf("Cannot %s as the provider is configured as read-only. "+
+			"Unset read_only in the configuration or the OCTOPUSDEPLOY_READ_ONLY environment variable to allow changes.",
+			operation,
+		),
+	)
+}
+
 func ErrUnexpectedDataSourceConfigureType(input any) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Unexpected data source configure type",
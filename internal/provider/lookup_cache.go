@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+// LookupCache memoises name to ID resolution for the lifetime of a provider
+// instance. The first lookup of a kind in a space prefetches every item of
+// that kind, and lookups that miss the prefetched items fall back to a direct
+// query which is cached by its parameters. A nil or disabled cache always
+// queries the API.
+type LookupCache struct {
+	enabled bool
+	mu      sync.Mutex
+	entries map[lookupKey]*lookupEntry
+}
+
+// lookupKey identifies a cached lookup. The query is empty for the prefetch of
+// every item of a kind, and holds the parameters of a direct query otherwise.
+type lookupKey struct {
+	spaceID string
+	kind    string
+	query   string
+}
+
+type lookupEntry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+func NewLookupCache(enabled bool) *LookupCache {
+	return &LookupCache{
+		enabled: enabled,
+		entries: map[lookupKey]*lookupEntry{},
+	}
+}
+
+// invalidate drops every cached lookup of a kind in a space, so that items
+// created, renamed or deleted by a resource are visible to later lookups.
+func (c *LookupCache) invalidate(spaceID, kind string) {
+	if c == nil || !c.enabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.spaceID == spaceID && key.kind == kind {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// cachedLookup returns the cached value for key, calling fetch at most once
+// per key across concurrent callers. Errors are returned but not cached.
+func cachedLookup[T any](ctx context.Context, c *LookupCache, key lookupKey, fetch func() (T, error)) (T, error) {
+	if c == nil || !c.enabled {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &lookupEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	fields := map[string]interface{}{"space_id": key.spaceID, "kind": key.kind, "query": key.query}
+	hit := true
+	entry.once.Do(func() {
+		hit = false
+		entry.value, entry.err = fetch()
+	})
+
+	var zero T
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+
+		return zero, entry.err
+	}
+
+	value, ok := entry.value.(T)
+	if !ok {
+		return zero, fmt.Errorf("found invalid type %T in lookup cache for %s", entry.value, key.kind)
+	}
+
+	if hit {
+		tflog.Debug(ctx, "lookup cache hit", fields)
+	} else {
+		tflog.Debug(ctx, "lookup cache populated", fields)
+	}
+
+	return value, nil
+}
+
+// matchesLookup reports whether an item matches every non-empty identifier.
+func matchesLookup(id, name, wantID, wantName string) bool {
+	if wantID != "" && id != wantID {
+		return false
+	}
+
+	if wantName != "" && !strings.EqualFold(name, wantName) {
+		return false
+	}
+
+	return true
+}
+
+// LookupProject finds a project by ID, name and/or slug, returning nil when it
+// does not exist.
+func (c *LookupCache) LookupProject(ctx context.Context, client *client.Client, spaceID, id, name, slug string) (*projects.Project, error) {
+	spaceID = resolveSpaceID(client, spaceID)
+
+	if c != nil && c.enabled {
+		all, err := cachedLookup(ctx, c, lookupKey{spaceID: spaceID, kind: "projects"}, func() ([]*projects.Project, error) {
+			return projects.GetAll(client, spaceID)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range all {
+			if matchesLookup(project.ID, project.Name, id, name) && (slug == "" || project.Slug == slug) {
+				return project, nil
+			}
+		}
+	}
+
+	if id == "" && name == "" && slug != "" {
+		// projects can be fetched directly by slug in place of their ID
+		key := lookupKey{spaceID: spaceID, kind: "projects", query: fmt.Sprintf("slug=%s", slug)}
+		return cachedLookup(ctx, c, key, func() (*projects.Project, error) {
+			project, err := projects.GetByID(client, spaceID, slug)
+			if isAPIErrorNotFound(err) {
+				return nil, nil
+			}
+
+			return project, err
+		})
+	}
+
+	query := projects.ProjectsQuery{Name: name, Take: 1}
+	if id != "" {
+		query.IDs = []string{id}
+	}
+
+	key := lookupKey{spaceID: spaceID, kind: "projects", query: fmt.Sprintf("id=%s&name=%s", id, name)}
+	return cachedLookup(ctx, c, key, func() (*projects.Project, error) {
+		resources, err := projects.Get(client, spaceID, query)
+		if err != nil || len(resources.Items) < 1 {
+			return nil, err
+		}
+
+		return resources.Items[0], nil
+	})
+}
+
+// LookupDefaultChannelID returns the ID of the default channel of the project,
+// or an empty string when it has none.
+func (c *LookupCache) LookupDefaultChannelID(ctx context.Context, client *client.Client, project *projects.Project) (string, error) {
+	key := lookupKey{spaceID: project.SpaceID, kind: "channels", query: fmt.Sprintf("project=%s", project.ID)}
+	return cachedLookup(ctx, c, key, func() (string, error) {
+		channels, err := client.Projects.GetChannels(project)
+		if err != nil {
+			return "", err
+		}
+
+		for _, channel := range channels {
+			if channel.IsDefault {
+				return channel.ID, nil
+			}
+		}
+
+		return "", nil
+	})
+}
+
+// LookupEnvironment finds an environment by ID and/or name, returning nil when
+// it does not exist.
+func (c *LookupCache) LookupEnvironment(ctx context.Context, client *client.Client, spaceID, id, name string) (*environments.Environment, error) {
+	spaceID = resolveSpaceID(client, spaceID)
+
+	if c != nil && c.enabled {
+		all, err := cachedLookup(ctx, c, lookupKey{spaceID: spaceID, kind: "environments"}, func() ([]*environments.Environment, error) {
+			return environments.GetAll(client, spaceID)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, environment := range all {
+			if matchesLookup(environment.ID, environment.Name, id, name) {
+				return environment, nil
+			}
+		}
+	}
+
+	query := environments.EnvironmentsQuery{Name: name, Take: 1}
+	if id != "" {
+		query.IDs = []string{id}
+	}
+
+	key := lookupKey{spaceID: spaceID, kind: "environments", query: fmt.Sprintf("id=%s&name=%s", id, name)}
+	return cachedLookup(ctx, c, key, func() (*environments.Environment, error) {
+		resources, err := environments.Get(client, spaceID, query)
+		if err != nil || len(resources.Items) < 1 {
+			return nil, err
+		}
+
+		return resources.Items[0], nil
+	})
+}
+
+// LookupTenant finds a tenant by ID and/or name, returning nil when it does not
+// exist.
+func (c *LookupCache) LookupTenant(ctx context.Context, client *client.Client, spaceID, id, name string) (*tenants.Tenant, error) {
+	spaceID = resolveSpaceID(client, spaceID)
+
+	if c != nil && c.enabled {
+		all, err := cachedLookup(ctx, c, lookupKey{spaceID: spaceID, kind: "tenants"}, func() ([]*tenants.Tenant, error) {
+			return tenants.GetAll(client, spaceID)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tenant := range all {
+			if matchesLookup(tenant.ID, tenant.Name, id, name) {
+				return tenant, nil
+			}
+		}
+	}
+
+	query := tenants.TenantsQuery{Name: name, Take: 1}
+	if id != "" {
+		query.IDs = []string{id}
+	}
+
+	key := lookupKey{spaceID: spaceID, kind: "tenants", query: fmt.Sprintf("id=%s&name=%s", id, name)}
+	return cachedLookup(ctx, c, key, func() (*tenants.Tenant, error) {
+		resources, err := tenants.Get(client, spaceID, query)
+		if err != nil || len(resources.Items) < 1 {
+			return nil, err
+		}
+
+		return resources.Items[0], nil
+	})
+}
+
+// LookupTenantsByTags finds every tenant which has all of the given canonical
+// tag names.
+func (c *LookupCache) LookupTenantsByTags(ctx context.Context, client *client.Client, spaceID string, tags []string) ([]*tenants.Tenant, error) {
+	spaceID = resolveSpaceID(client, spaceID)
+
+	var candidates []*tenants.Tenant
+	var err error
+	if c != nil && c.enabled {
+		candidates, err = cachedLookup(ctx, c, lookupKey{spaceID: spaceID, kind: "tenants"}, func() ([]*tenants.Tenant, error) {
+			return tenants.GetAll(client, spaceID)
+		})
+	} else {
+		candidates, err = getAllPages(ctx, func(skip, take int) (*resources.Resources[*tenants.Tenant], error) {
+			return tenants.Get(client, spaceID, tenants.TenantsQuery{Tags: tags, Skip: skip, Take: take})
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*tenants.Tenant{}
+	for _, tenant := range candidates {
+		if hasAllTags(tenant.TenantTags, tags) {
+			matches = append(matches, tenant)
+		}
+	}
+
+	return matches, nil
+}
+
+// hasAllTags reports whether every wanted canonical tag name is present.
+func hasAllTags(tags, want []string) bool {
+	for _, tag := range want {
+		if !slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasAnyTag reports whether at least one wanted canonical tag name is present.
+func hasAnyTag(tags, want []string) bool {
+	for _, tag := range want {
+		if slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			return true
+		}
+	}
+
+	return false
+}
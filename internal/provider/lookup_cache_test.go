@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedLookupFetchesOncePerKey(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLookupCache(true)
+	keys := []lookupKey{
+		{spaceID: "Spaces-1", kind: "projects"},
+		{spaceID: "Spaces-1", kind: "projects", query: "id=&name=a"},
+		{spaceID: "Spaces-2", kind: "projects"},
+	}
+
+	fetches := map[lookupKey]*atomic.Int32{}
+	for _, key := range keys {
+		fetches[key] = &atomic.Int32{}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		for _, key := range keys {
+			wg.Add(1)
+			go func(key lookupKey) {
+				defer wg.Done()
+
+				value, err := cachedLookup(ctx, cache, key, func() (string, error) {
+					fetches[key].Add(1)
+					time.Sleep(10 * time.Millisecond)
+					return key.spaceID + key.query, nil
+				})
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				if want := key.spaceID + key.query; value != want {
+					t.Errorf("expected %q, got %q", want, value)
+				}
+			}(key)
+		}
+	}
+
+	wg.Wait()
+
+	for key, count := range fetches {
+		if got := count.Load(); got != 1 {
+			t.Errorf("expected 1 fetch of %+v, got %d", key, got)
+		}
+	}
+}
+
+func TestCachedLookupDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLookupCache(true)
+	key := lookupKey{spaceID: "Spaces-1", kind: "tenants"}
+
+	fetches := 0
+	fetch := func() (string, error) {
+		fetches++
+		if fetches == 1 {
+			return "", errors.New("unavailable")
+		}
+
+		return "Tenants-1", nil
+	}
+
+	if _, err := cachedLookup(ctx, cache, key, fetch); err == nil {
+		t.Fatal("expected the first lookup to fail")
+	}
+
+	for i := 0; i < 2; i++ {
+		value, err := cachedLookup(ctx, cache, key, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if value != "Tenants-1" {
+			t.Fatalf("expected Tenants-1, got %q", value)
+		}
+	}
+
+	if fetches != 2 {
+		t.Errorf("expected 2 fetches, got %d", fetches)
+	}
+}
+
+func TestLookupCacheInvalidate(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLookupCache(true)
+	keys := []lookupKey{
+		{spaceID: "Spaces-1", kind: "tenants"},
+		{spaceID: "Spaces-1", kind: "tenants", query: "id=&name=a"},
+		{spaceID: "Spaces-1", kind: "projects"},
+		{spaceID: "Spaces-2", kind: "tenants"},
+	}
+
+	fetches := map[lookupKey]int{}
+	lookup := func(key lookupKey) {
+		_, err := cachedLookup(ctx, cache, key, func() (int, error) {
+			fetches[key]++
+			return fetches[key], nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	for _, key := range keys {
+		lookup(key)
+	}
+
+	cache.invalidate("Spaces-1", "tenants")
+
+	for _, key := range keys {
+		lookup(key)
+	}
+
+	want := map[lookupKey]int{keys[0]: 2, keys[1]: 2, keys[2]: 1, keys[3]: 1}
+	for key, count := range want {
+		if fetches[key] != count {
+			t.Errorf("expected %d fetches of %+v, got %d", count, key, fetches[key])
+		}
+	}
+}
+
+func TestCachedLookupDisabled(t *testing.T) {
+	ctx := context.Background()
+	key := lookupKey{spaceID: "Spaces-1", kind: "environments"}
+
+	for name, cache := range map[string]*LookupCache{"nil": nil, "disabled": NewLookupCache(false)} {
+		t.Run(name, func(t *testing.T) {
+			fetches := 0
+			for i := 0; i < 2; i++ {
+				if _, err := cachedLookup(ctx, cache, key, func() (int, error) { fetches++; return fetches, nil }); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			cache.invalidate("Spaces-1", "environments")
+
+			if fetches != 2 {
+				t.Errorf("expected 2 fetches, got %d", fetches)
+			}
+		})
+	}
+}
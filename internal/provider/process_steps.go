@@ -0,0 +1,896 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+)
+
+// Properties of steps and actions which are managed by their own attributes
+// rather than the properties map.
+const (
+	stepTargetRolesProperty         = "Octopus.Action.TargetRoles"
+	stepConditionExpressionProperty = "Octopus.Step.ConditionVariableExpression"
+	scriptSourceProperty            = "Octopus.Action.Script.ScriptSource"
+	scriptSyntaxProperty            = "Octopus.Action.Script.Syntax"
+	scriptBodyProperty              = "Octopus.Action.Script.ScriptBody"
+	scriptFileNameProperty          = "Octopus.Action.Script.ScriptFileName"
+	scriptParametersProperty        = "Octopus.Action.Script.ScriptParameters"
+)
+
+var (
+	stepConditions = []string{
+		string(deployments.DeploymentStepConditionTypeSuccess),
+		string(deployments.DeploymentStepConditionTypeFailure),
+		string(deployments.DeploymentStepConditionTypeAlways),
+		string(deployments.DeploymentStepConditionTypeVariable),
+	}
+	stepStartTriggers = []string{
+		string(deployments.DeploymentStepStartTriggerStartAfterPrevious),
+		string(deployments.DeploymentStepStartTriggerStartWithPrevious),
+	}
+	stepPackageRequirements = []string{
+		string(deployments.DeploymentStepPackageRequirementLetOctopusDecide),
+		string(deployments.DeploymentStepPackageRequirementBeforePackageAcquisition),
+		string(deployments.DeploymentStepPackageRequirementAfterPackageAcquisition),
+	}
+	scriptSyntaxes    = []string{"PowerShell", "Bash", "CSharp", "FSharp", "Python"}
+	scriptProperties  = []string{scriptSourceProperty, scriptSyntaxProperty, scriptBodyProperty, scriptFileNameProperty, scriptParametersProperty}
+	stepOwnProperties = []string{stepTargetRolesProperty, stepConditionExpressionProperty}
+)
+
+// ProcessStepModel describes a step of a deployment or runbook process.
+type ProcessStepModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	Name                types.String         `tfsdk:"name"`
+	Condition           types.String         `tfsdk:"condition"`
+	ConditionExpression types.String         `tfsdk:"condition_expression"`
+	StartTrigger        types.String         `tfsdk:"start_trigger"`
+	PackageRequirement  types.String         `tfsdk:"package_requirement"`
+	TargetRoles         types.Set            `tfsdk:"target_roles"`
+	Properties          types.Map            `tfsdk:"properties"`
+	Actions             []ProcessActionModel `tfsdk:"actions"`
+}
+
+// ProcessActionModel describes an action of a process step.
+type ProcessActionModel struct {
+	ID                   types.String                 `tfsdk:"id"`
+	Name                 types.String                 `tfsdk:"name"`
+	ActionType           types.String                 `tfsdk:"action_type"`
+	Notes                types.String                 `tfsdk:"notes"`
+	IsDisabled           types.Bool                   `tfsdk:"is_disabled"`
+	IsRequired           types.Bool                   `tfsdk:"is_required"`
+	Environments         types.Set                    `tfsdk:"environments"`
+	ExcludedEnvironments types.Set                    `tfsdk:"excluded_environments"`
+	Channels             types.Set                    `tfsdk:"channels"`
+	TenantTags           types.Set                    `tfsdk:"tenant_tags"`
+	WorkerPoolID         types.String                 `tfsdk:"worker_pool_id"`
+	WorkerPoolVariable   types.String                 `tfsdk:"worker_pool_variable"`
+	Container            *ProcessActionContainerModel `tfsdk:"container"`
+	Script               *ProcessActionScriptModel    `tfsdk:"script"`
+	Packages             []ProcessActionPackageModel  `tfsdk:"packages"`
+	Properties           types.Map                    `tfsdk:"properties"`
+}
+
+// ProcessActionContainerModel describes the container image an action runs in.
+type ProcessActionContainerModel struct {
+	FeedID types.String `tfsdk:"feed_id"`
+	Image  types.String `tfsdk:"image"`
+}
+
+// ProcessActionScriptModel describes the script an action runs, either inline
+// or from the action's primary package.
+type ProcessActionScriptModel struct {
+	Syntax     types.String `tfsdk:"syntax"`
+	Body       types.String `tfsdk:"body"`
+	FileName   types.String `tfsdk:"file_name"`
+	Parameters types.String `tfsdk:"parameters"`
+}
+
+// ProcessActionPackageModel describes a package referenced by an action.
+type ProcessActionPackageModel struct {
+	Name                types.String `tfsdk:"name"`
+	PackageID           types.String `tfsdk:"package_id"`
+	FeedID              types.String `tfsdk:"feed_id"`
+	AcquisitionLocation types.String `tfsdk:"acquisition_location"`
+	Properties          types.Map    `tfsdk:"properties"`
+}
+
+// expandPropertyMap returns the values of a properties map, or nil when the
+// map is null or unknown.
+func expandPropertyMap(ctx context.Context, in types.Map) (map[string]string, diag.Diagnostics) {
+	if in.IsNull() || in.IsUnknown() {
+		return nil, nil
+	}
+
+	out := map[string]string{}
+	diags := in.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+// flattenOwnedProperties returns the properties which are owned, being those
+// whose keys are in the prior properties. Octopus adds defaults to steps and
+// actions, which are left out so they don't show as drift. Every property but
+// the excluded ones is owned when there are no prior properties, on import.
+func flattenOwnedProperties(values, prior map[string]string, excluded []string) types.Map {
+	out := map[string]attr.Value{}
+	for key, value := range values {
+		if _, owned := prior[key]; owned || (prior == nil && !slices.Contains(excluded, key)) {
+			out[key] = types.StringValue(value)
+		}
+	}
+
+	// Octopus drops empty properties
+	for key, value := range prior {
+		if _, ok := values[key]; !ok && value == "" {
+			out[key] = types.StringValue("")
+		}
+	}
+
+	return types.MapValueMust(types.StringType, out)
+}
+
+// mergeOwnedProperties sets the planned properties over the existing ones, and
+// removes those which were owned but are no longer planned. Properties which
+// were never owned, such as Octopus defaults, are kept as they are.
+func mergeOwnedProperties[V any](existing map[string]V, planned, prior map[string]string, value func(key, planned string) V) map[string]V {
+	merged := map[string]V{}
+	for key, existingValue := range existing {
+		_, owned := prior[key]
+		_, stillOwned := planned[key]
+		if !owned || stillOwned {
+			merged[key] = existingValue
+		}
+	}
+
+	for key, plannedValue := range planned {
+		merged[key] = value(key, plannedValue)
+	}
+
+	return merged
+}
+
+// propertyValues returns the values of step or action properties. Sensitive
+// values are not returned by Octopus, so the prior value is used instead.
+func propertyValues(properties map[string]core.PropertyValue, prior map[string]string) map[string]string {
+	values := map[string]string{}
+	for key, property := range properties {
+		values[key] = property.Value
+		if property.IsSensitive {
+			values[key] = prior[key]
+		}
+	}
+
+	return values
+}
+
+// mergePropertyValues merges planned step or action properties into the
+// existing ones, keeping sensitive values which have not changed.
+func mergePropertyValues(existing map[string]core.PropertyValue, planned, prior map[string]string) map[string]core.PropertyValue {
+	return mergeOwnedProperties(existing, planned, prior, func(key, value string) core.PropertyValue {
+		current, ok := existing[key]
+		if !ok || !current.IsSensitive {
+			return core.NewPropertyValue(value, false)
+		}
+
+		if priorValue, owned := prior[key]; owned && priorValue == value {
+			return current
+		}
+
+		return core.NewPropertyValue(value, true)
+	})
+}
+
+// findProcessStep returns the step with the given ID, or with the given name
+// when it has no known ID.
+func findProcessStep(steps []ProcessStepModel, id types.String, name string) *ProcessStepModel {
+	for i, step := range steps {
+		if !id.IsUnknown() && !id.IsNull() && step.ID.Equal(id) {
+			return &steps[i]
+		}
+	}
+
+	for i, step := range steps {
+		if (id.IsUnknown() || id.IsNull()) && step.Name.ValueString() == name {
+			return &steps[i]
+		}
+	}
+
+	return nil
+}
+
+// findProcessAction returns the action with the given ID, or with the given
+// name when it has no known ID.
+func findProcessAction(actions []ProcessActionModel, id types.String, name string) *ProcessActionModel {
+	for i, action := range actions {
+		if !id.IsUnknown() && !id.IsNull() && action.ID.Equal(id) {
+			return &actions[i]
+		}
+	}
+
+	for i, action := range actions {
+		if (id.IsUnknown() || id.IsNull()) && action.Name.ValueString() == name {
+			return &actions[i]
+		}
+	}
+
+	return nil
+}
+
+// matchProcessStepIDs fills in the IDs of planned steps and actions which
+// already exist, so reordering steps doesn't replace them. Steps are matched by
+// name or position, and actions within a matched step likewise.
+func matchProcessStepIDs(planned, existing []ProcessStepModel) {
+	stepIDs := matchNestedIDs(planned, existing, func(step ProcessStepModel) (types.String, types.String) { return step.ID, step.Name })
+	for i := range planned {
+		step := &planned[i]
+		step.ID = types.StringUnknown()
+		if !stepIDs[i].IsNull() {
+			step.ID = stepIDs[i]
+		}
+
+		var existingActions []ProcessActionModel
+		if !stepIDs[i].IsNull() {
+			if prior := findProcessStep(existing, stepIDs[i], ""); prior != nil {
+				existingActions = prior.Actions
+			}
+		}
+
+		actionIDs := matchNestedIDs(step.Actions, existingActions, func(action ProcessActionModel) (types.String, types.String) { return action.ID, action.Name })
+		for j := range step.Actions {
+			step.Actions[j].ID = types.StringUnknown()
+			if !actionIDs[j].IsNull() {
+				step.Actions[j].ID = actionIDs[j]
+			}
+		}
+	}
+}
+
+// expandProcessSteps converts planned steps to the steps of a process. Steps
+// and actions which already exist are updated in place, so settings this
+// provider doesn't manage are kept, and properties are merged with those owned
+// in the prior steps.
+func expandProcessSteps(ctx context.Context, planned, prior []ProcessStepModel, existing []*deployments.DeploymentStep) ([]*deployments.DeploymentStep, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	steps := []*deployments.DeploymentStep{}
+	for _, model := range planned {
+		step := deployments.NewDeploymentStep(model.Name.ValueString())
+		for _, existingStep := range existing {
+			if model.ID.ValueString() != "" && existingStep.ID == model.ID.ValueString() {
+				copied := *existingStep
+				step = &copied
+			}
+		}
+
+		var priorActions []ProcessActionModel
+		priorProperties := map[string]string{}
+		if priorStep := findProcessStep(prior, model.ID, model.Name.ValueString()); priorStep != nil {
+			priorActions = priorStep.Actions
+
+			var nestedDiags diag.Diagnostics
+			priorProperties, nestedDiags = expandPropertyMap(ctx, priorStep.Properties)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		plannedProperties, nestedDiags := expandPropertyMap(ctx, model.Properties)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		step.ID = model.ID.ValueString()
+		if model.ID.IsUnknown() {
+			step.ID = ""
+		}
+
+		step.Name = model.Name.ValueString()
+		step.Condition = deployments.DeploymentStepConditionType(model.Condition.ValueString())
+		step.StartTrigger = deployments.DeploymentStepStartTrigger(model.StartTrigger.ValueString())
+		step.PackageRequirement = deployments.DeploymentStepPackageRequirement(model.PackageRequirement.ValueString())
+		step.Properties = mergePropertyValues(step.Properties, plannedProperties, priorProperties)
+
+		step.TargetRoles, nestedDiags = expandStringSet(ctx, model.TargetRoles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		delete(step.Properties, stepTargetRolesProperty)
+		if len(step.TargetRoles) > 0 {
+			step.Properties[stepTargetRolesProperty] = core.NewPropertyValue(strings.Join(step.TargetRoles, ","), false)
+		}
+
+		delete(step.Properties, stepConditionExpressionProperty)
+		if expression := model.ConditionExpression.ValueString(); expression != "" {
+			step.Properties[stepConditionExpressionProperty] = core.NewPropertyValue(expression, false)
+		}
+
+		var existingActions []*deployments.DeploymentAction
+		if step.ID != "" {
+			existingActions = step.Actions
+		}
+
+		step.Actions = []*deployments.DeploymentAction{}
+		for _, actionModel := range model.Actions {
+			action, nestedDiags := expandProcessAction(ctx, actionModel, findProcessAction(priorActions, actionModel.ID, actionModel.Name.ValueString()), existingActions)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+
+			step.Actions = append(step.Actions, action)
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, diags
+}
+
+// expandProcessAction converts a planned action, updating the existing action
+// with the same ID when there is one.
+func expandProcessAction(ctx context.Context, model ProcessActionModel, prior *ProcessActionModel, existing []*deployments.DeploymentAction) (*deployments.DeploymentAction, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	action := deployments.NewDeploymentAction(model.Name.ValueString(), model.ActionType.ValueString())
+	for _, existingAction := range existing {
+		if model.ID.ValueString() != "" && existingAction.ID == model.ID.ValueString() {
+			copied := *existingAction
+			action = &copied
+		}
+	}
+
+	priorProperties := map[string]string{}
+	var priorPackages []ProcessActionPackageModel
+	priorScript := false
+	if prior != nil {
+		var nestedDiags diag.Diagnostics
+		priorProperties, nestedDiags = expandPropertyMap(ctx, prior.Properties)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		priorPackages = prior.Packages
+		priorScript = prior.Script != nil
+	}
+
+	plannedProperties, nestedDiags := expandPropertyMap(ctx, model.Properties)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	action.ID = model.ID.ValueString()
+	if model.ID.IsUnknown() {
+		action.ID = ""
+	}
+
+	action.Name = model.Name.ValueString()
+	action.ActionType = model.ActionType.ValueString()
+	action.Notes = model.Notes.ValueString()
+	action.IsDisabled = model.IsDisabled.ValueBool()
+	action.IsRequired = model.IsRequired.ValueBool()
+	action.WorkerPool = model.WorkerPoolID.ValueString()
+	action.WorkerPoolVariable = model.WorkerPoolVariable.ValueString()
+	action.Properties = mergePropertyValues(action.Properties, plannedProperties, priorProperties)
+
+	action.Container = nil
+	if container := model.Container; container != nil {
+		action.Container = &deployments.DeploymentActionContainer{
+			FeedID: container.FeedID.ValueString(),
+			Image:  container.Image.ValueString(),
+		}
+	}
+
+	if script := model.Script; script != nil || priorScript {
+		for _, key := range scriptProperties {
+			delete(action.Properties, key)
+		}
+
+		if script != nil {
+			source := "Inline"
+			if script.Body.IsNull() {
+				source = "Package"
+			}
+
+			values := map[string]types.String{
+				scriptSourceProperty:     types.StringValue(source),
+				scriptSyntaxProperty:     script.Syntax,
+				scriptBodyProperty:       script.Body,
+				scriptFileNameProperty:   script.FileName,
+				scriptParametersProperty: script.Parameters,
+			}
+
+			for key, value := range values {
+				if value.ValueString() != "" {
+					action.Properties[key] = core.NewPropertyValue(value.ValueString(), false)
+				}
+			}
+		}
+	}
+
+	for _, field := range []struct {
+		model types.Set
+		out   *[]string
+	}{
+		{model.Environments, &action.Environments},
+		{model.ExcludedEnvironments, &action.ExcludedEnvironments},
+		{model.Channels, &action.Channels},
+		{model.TenantTags, &action.TenantTags},
+	} {
+		*field.out, nestedDiags = expandStringSet(ctx, field.model)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	existingPackages := action.Packages
+	action.Packages = []*packages.PackageReference{}
+	for _, packageModel := range model.Packages {
+		reference := &packages.PackageReference{Properties: map[string]string{}}
+		for _, existingPackage := range existingPackages {
+			if existingPackage.Name == packageModel.Name.ValueString() {
+				copied := *existingPackage
+				reference = &copied
+			}
+		}
+
+		priorPackageProperties := map[string]string{}
+		for _, priorPackage := range priorPackages {
+			if priorPackage.Name.Equal(packageModel.Name) {
+				priorPackageProperties, nestedDiags = expandPropertyMap(ctx, priorPackage.Properties)
+				if diags.Append(nestedDiags...); diags.HasError() {
+					return nil, diags
+				}
+			}
+		}
+
+		plannedPackageProperties, nestedDiags := expandPropertyMap(ctx, packageModel.Properties)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		reference.Name = packageModel.Name.ValueString()
+		reference.PackageID = packageModel.PackageID.ValueString()
+		reference.FeedID = packageModel.FeedID.ValueString()
+		reference.AcquisitionLocation = packageModel.AcquisitionLocation.ValueString()
+		reference.Properties = mergeOwnedProperties(reference.Properties, plannedPackageProperties, priorPackageProperties, func(_, value string) string { return value })
+
+		action.Packages = append(action.Packages, reference)
+	}
+
+	return action, diags
+}
+
+// flattenProcessSteps converts the steps of a process to models. Only the
+// properties owned by the prior steps are included, or all of them when there
+// are no prior steps, on import.
+func flattenProcessSteps(ctx context.Context, steps []*deployments.DeploymentStep, prior []ProcessStepModel, isImport bool) ([]ProcessStepModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	models := []ProcessStepModel{}
+	for _, step := range steps {
+		var priorStep *ProcessStepModel
+		var priorProperties map[string]string
+		if !isImport {
+			priorProperties = map[string]string{}
+			priorStep = findProcessStep(prior, types.StringValue(step.ID), "")
+			if priorStep == nil {
+				priorStep = findProcessStep(prior, types.StringUnknown(), step.Name)
+			}
+		}
+
+		if priorStep != nil {
+			var nestedDiags diag.Diagnostics
+			priorProperties, nestedDiags = expandPropertyMap(ctx, priorStep.Properties)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		values := propertyValues(step.Properties, priorProperties)
+
+		model := ProcessStepModel{
+			ID:                  types.StringValue(step.ID),
+			Name:                types.StringValue(step.Name),
+			Condition:           types.StringValue(string(step.Condition)),
+			ConditionExpression: types.StringNull(),
+			StartTrigger:        types.StringValue(string(step.StartTrigger)),
+			PackageRequirement:  types.StringValue(string(step.PackageRequirement)),
+			Properties:          flattenOwnedProperties(values, priorProperties, stepOwnProperties),
+		}
+
+		if expression := values[stepConditionExpressionProperty]; expression != "" {
+			model.ConditionExpression = types.StringValue(expression)
+		}
+
+		roles := []string{}
+		for _, role := range strings.Split(values[stepTargetRolesProperty], ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+
+		var nestedDiags diag.Diagnostics
+		model.TargetRoles, nestedDiags = flattenStringSet(ctx, roles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		var priorActions []ProcessActionModel
+		if priorStep != nil {
+			priorActions = priorStep.Actions
+		}
+
+		model.Actions = []ProcessActionModel{}
+		for _, action := range step.Actions {
+			var priorAction *ProcessActionModel
+			if !isImport {
+				priorAction = findProcessAction(priorActions, types.StringValue(action.ID), "")
+				if priorAction == nil {
+					priorAction = findProcessAction(priorActions, types.StringUnknown(), action.Name)
+				}
+			}
+
+			actionModel, nestedDiags := flattenProcessAction(ctx, action, priorAction, isImport)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+
+			model.Actions = append(model.Actions, *actionModel)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, diags
+}
+
+// flattenProcessAction converts an action of a process step to a model.
+func flattenProcessAction(ctx context.Context, action *deployments.DeploymentAction, prior *ProcessActionModel, isImport bool) (*ProcessActionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorProperties map[string]string
+	var priorPackages []ProcessActionPackageModel
+	if !isImport {
+		priorProperties = map[string]string{}
+	}
+
+	if prior != nil {
+		var nestedDiags diag.Diagnostics
+		priorProperties, nestedDiags = expandPropertyMap(ctx, prior.Properties)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		priorPackages = prior.Packages
+	}
+
+	values := propertyValues(action.Properties, priorProperties)
+	manageScript := (isImport && values[scriptSourceProperty] != "") || (prior != nil && prior.Script != nil)
+
+	excluded := []string{}
+	if manageScript {
+		excluded = scriptProperties
+	}
+
+	model := ProcessActionModel{
+		ID:                 types.StringValue(action.ID),
+		Name:               types.StringValue(action.Name),
+		ActionType:         types.StringValue(action.ActionType),
+		Notes:              types.StringValue(action.Notes),
+		IsDisabled:         types.BoolValue(action.IsDisabled),
+		IsRequired:         types.BoolValue(action.IsRequired),
+		WorkerPoolID:       types.StringNull(),
+		WorkerPoolVariable: types.StringNull(),
+		Properties:         flattenOwnedProperties(values, priorProperties, excluded),
+	}
+
+	if action.WorkerPool != "" {
+		model.WorkerPoolID = types.StringValue(action.WorkerPool)
+	}
+
+	if action.WorkerPoolVariable != "" {
+		model.WorkerPoolVariable = types.StringValue(action.WorkerPoolVariable)
+	}
+
+	if container := action.Container; container != nil && container.Image != "" {
+		model.Container = &ProcessActionContainerModel{
+			FeedID: types.StringValue(container.FeedID),
+			Image:  types.StringValue(container.Image),
+		}
+	}
+
+	if manageScript {
+		optional := func(key string) types.String {
+			if values[key] == "" {
+				return types.StringNull()
+			}
+
+			return types.StringValue(values[key])
+		}
+
+		model.Script = &ProcessActionScriptModel{
+			Syntax:     optional(scriptSyntaxProperty),
+			Body:       optional(scriptBodyProperty),
+			FileName:   optional(scriptFileNameProperty),
+			Parameters: optional(scriptParametersProperty),
+		}
+	}
+
+	var nestedDiags diag.Diagnostics
+	for _, field := range []struct {
+		in  []string
+		out *types.Set
+	}{
+		{action.Environments, &model.Environments},
+		{action.ExcludedEnvironments, &model.ExcludedEnvironments},
+		{action.Channels, &model.Channels},
+		{action.TenantTags, &model.TenantTags},
+	} {
+		*field.out, nestedDiags = flattenStringSet(ctx, field.in)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	for _, reference := range action.Packages {
+		var priorPackageProperties map[string]string
+		if !isImport {
+			priorPackageProperties = map[string]string{}
+		}
+
+		for _, priorPackage := range priorPackages {
+			if priorPackage.Name.ValueString() == reference.Name {
+				priorPackageProperties, nestedDiags = expandPropertyMap(ctx, priorPackage.Properties)
+				if diags.Append(nestedDiags...); diags.HasError() {
+					return nil, diags
+				}
+			}
+		}
+
+		model.Packages = append(model.Packages, ProcessActionPackageModel{
+			Name:                types.StringValue(reference.Name),
+			PackageID:           types.StringValue(reference.PackageID),
+			FeedID:              types.StringValue(reference.FeedID),
+			AcquisitionLocation: types.StringValue(reference.AcquisitionLocation),
+			Properties:          flattenOwnedProperties(reference.Properties, priorPackageProperties, nil),
+		})
+	}
+
+	return &model, diags
+}
+
+// processStepsResourceAttribute returns the schema of the ordered steps of a
+// deployment or runbook process.
+func processStepsResourceAttribute() schema.ListNestedAttribute {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{}))
+	emptyMap := mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{}))
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The steps of the process, in the order they run",
+		Required:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The unique identifier of the step",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the step",
+					Required:            true,
+				},
+				"condition": schema.StringAttribute{
+					MarkdownDescription: "When the step runs, one of `Success`, `Failure`, `Always` or `Variable`",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(string(deployments.DeploymentStepConditionTypeSuccess)),
+					Validators:          []validator.String{stringvalidator.OneOf(stepConditions...)},
+				},
+				"condition_expression": schema.StringAttribute{
+					MarkdownDescription: "The variable expression deciding whether the step runs, when `condition` is `Variable`",
+					Optional:            true,
+				},
+				"start_trigger": schema.StringAttribute{
+					MarkdownDescription: "Either `StartAfterPrevious`, or `StartWithPrevious` to run in parallel with the previous step",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(string(deployments.DeploymentStepStartTriggerStartAfterPrevious)),
+					Validators:          []validator.String{stringvalidator.OneOf(stepStartTriggers...)},
+				},
+				"package_requirement": schema.StringAttribute{
+					MarkdownDescription: "When the step runs relative to packages being acquired, one of `LetOctopusDecide`, `BeforePackageAcquisition` or `AfterPackageAcquisition`",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(string(deployments.DeploymentStepPackageRequirementLetOctopusDecide)),
+					Validators:          []validator.String{stringvalidator.OneOf(stepPackageRequirements...)},
+				},
+				"target_roles": schema.SetAttribute{
+					MarkdownDescription: "Roles of the deployment targets the step runs on",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Default:             emptySet,
+				},
+				"properties": schema.MapAttribute{
+					MarkdownDescription: "Properties of the step. Only the properties set here are managed, so defaults added by Octopus are left alone",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Default:             emptyMap,
+				},
+				"actions": schema.ListNestedAttribute{
+					MarkdownDescription: "The actions of the step, usually one",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the action",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the action",
+								Required:            true,
+							},
+							"action_type": schema.StringAttribute{
+								MarkdownDescription: "The type of the action, such as `Octopus.Script`",
+								Required:            true,
+							},
+							"notes": schema.StringAttribute{
+								MarkdownDescription: "Notes about the action",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString(""),
+							},
+							"is_disabled": schema.BoolAttribute{
+								MarkdownDescription: "Whether the action is skipped",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"is_required": schema.BoolAttribute{
+								MarkdownDescription: "Whether the action cannot be skipped when deploying",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"environments": schema.SetAttribute{
+								MarkdownDescription: "IDs of the only environments the action runs in",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             emptySet,
+							},
+							"excluded_environments": schema.SetAttribute{
+								MarkdownDescription: "IDs of environments the action doesn't run in",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             emptySet,
+							},
+							"channels": schema.SetAttribute{
+								MarkdownDescription: "IDs of the only channels the action runs in",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             emptySet,
+							},
+							"tenant_tags": schema.SetAttribute{
+								MarkdownDescription: "Canonical names of the tags of the only tenants the action runs for",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             emptySet,
+							},
+							"worker_pool_id": schema.StringAttribute{
+								MarkdownDescription: "ID of the worker pool the action runs on",
+								Optional:            true,
+							},
+							"worker_pool_variable": schema.StringAttribute{
+								MarkdownDescription: "Name of a variable holding the worker pool the action runs on",
+								Optional:            true,
+							},
+							"container": schema.SingleNestedAttribute{
+								MarkdownDescription: "The container image the action runs in",
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"feed_id": schema.StringAttribute{
+										MarkdownDescription: "ID of the feed the image is pulled from",
+										Required:            true,
+									},
+									"image": schema.StringAttribute{
+										MarkdownDescription: "The name and tag of the image",
+										Required:            true,
+									},
+								},
+							},
+							"script": schema.SingleNestedAttribute{
+								MarkdownDescription: "The script the action runs, either inline with `body` or from the action's primary package with `file_name`",
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"syntax": schema.StringAttribute{
+										MarkdownDescription: "The language of an inline script, one of `PowerShell`, `Bash`, `CSharp`, `FSharp` or `Python`",
+										Optional:            true,
+										Validators:          []validator.String{stringvalidator.OneOf(scriptSyntaxes...)},
+									},
+									"body": schema.StringAttribute{
+										MarkdownDescription: "The inline script",
+										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("file_name")),
+											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("syntax")),
+										},
+									},
+									"file_name": schema.StringAttribute{
+										MarkdownDescription: "The path of the script in the primary package",
+										Optional:            true,
+									},
+									"parameters": schema.StringAttribute{
+										MarkdownDescription: "Parameters passed to the script in the package",
+										Optional:            true,
+									},
+								},
+							},
+							"packages": schema.ListNestedAttribute{
+								MarkdownDescription: "Packages referenced by the action",
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											MarkdownDescription: "The name of the package reference, empty for the action's primary package",
+											Optional:            true,
+											Computed:            true,
+											Default:             stringdefault.StaticString(""),
+										},
+										"package_id": schema.StringAttribute{
+											MarkdownDescription: "The ID of the package in the feed",
+											Required:            true,
+										},
+										"feed_id": schema.StringAttribute{
+											MarkdownDescription: "ID of the feed the package is acquired from",
+											Required:            true,
+										},
+										"acquisition_location": schema.StringAttribute{
+											MarkdownDescription: "Where the package is acquired, such as `Server`, `ExecutionTarget` or `NotAcquired`",
+											Optional:            true,
+											Computed:            true,
+											Default:             stringdefault.StaticString("Server"),
+										},
+										"properties": schema.MapAttribute{
+											MarkdownDescription: "Properties of the package reference. Only the properties set here are managed",
+											Optional:            true,
+											Computed:            true,
+											ElementType:         types.StringType,
+											Default:             emptyMap,
+										},
+									},
+								},
+							},
+							"properties": schema.MapAttribute{
+								MarkdownDescription: "Properties of the action, such as `Octopus.Action.RunOnServer`. Only the properties set here are managed, so defaults added by Octopus are left alone",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             emptyMap,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
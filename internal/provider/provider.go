@@ -5,11 +5,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,9 +35,19 @@ type OctopusDeployProvider struct {
 
 // OctopusDeployProviderModel describes the provider data model.
 type OctopusDeployProviderModel struct {
-	SpaceID   types.String `tfsdk:"space_id"`
-	ServerURL types.String `tfsdk:"server_url"`
-	APIKey    types.String `tfsdk:"api_key"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	ServerURL   types.String `tfsdk:"server_url"`
+	APIKey      types.String `tfsdk:"api_key"`
+	LookupCache types.Bool   `tfsdk:"lookup_cache"`
+	ReadOnly    types.Bool   `tfsdk:"read_only"`
+}
+
+// OctopusDeployProviderData is handed to resources and data sources when they
+// are configured.
+type OctopusDeployProviderData struct {
+	Client   *client.Client
+	Cache    *LookupCache
+	ReadOnly bool
 }
 
 func (p *OctopusDeployProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,6 +71,14 @@ func (p *OctopusDeployProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"lookup_cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether data sources share a cache of projects, environments and tenants for the duration of a run. Defaults to `true`",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse every create, update and delete so that the provider can only be used to plan. Can be set with the environment variable `OCTOPUSDEPLOY_READ_ONLY`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -82,9 +103,23 @@ func (p *OctopusDeployProvider) Configure(ctx context.Context, req provider.Conf
 		resp.Diagnostics.Append(ErrUnknownProviderAttribute("api_key", "OCTOPUSDEPLOY_API_KEY"))
 	}
 
+	if data.ReadOnly.IsUnknown() {
+		resp.Diagnostics.Append(ErrUnknownProviderAttribute("read_only", "OCTOPUSDEPLOY_READ_ONLY"))
+	}
+
 	spaceID := os.Getenv("OCTOPUSDEPLOY_SPACE_ID")
 	serverURL := os.Getenv("OCTOPUSDEPLOY_SERVER_URL")
 	apiKey := os.Getenv("OCTOPUSDEPLOY_API_KEY")
+	readOnly := false
+	if env := os.Getenv("OCTOPUSDEPLOY_READ_ONLY"); env != "" {
+		parsed, err := strconv.ParseBool(env)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("read_only"), "Invalid read_only", fmt.Sprintf("Failed to parse OCTOPUSDEPLOY_READ_ONLY: %s", err))
+		}
+
+		readOnly = parsed
+	}
+
 	ctx = tflog.SetField(ctx, "space_id", spaceID)
 	ctx = tflog.SetField(ctx, "server_url", serverURL)
 	ctx = tflog.SetField(ctx, "api_key", apiKey)
@@ -103,6 +138,10 @@ func (p *OctopusDeployProvider) Configure(ctx context.Context, req provider.Conf
 		apiKey = data.APIKey.ValueString()
 	}
 
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
 	if spaceID == "" {
 		resp.Diagnostics.Append(ErrMissingProviderAttribute("space_url", "OCTOPUSDEPLOY_SPACE_ID"))
 	}
@@ -118,6 +157,7 @@ func (p *OctopusDeployProvider) Configure(ctx context.Context, req provider.Conf
 	ctx = tflog.SetField(ctx, "space_id", spaceID)
 	ctx = tflog.SetField(ctx, "server_url", serverURL)
 	ctx = tflog.SetField(ctx, "api_key", apiKey)
+	ctx = tflog.SetField(ctx, "read_only", readOnly)
 	tflog.Info(ctx, "Provider configuration resolved")
 
 	if resp.Diagnostics.HasError() {
@@ -136,25 +176,69 @@ func (p *OctopusDeployProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	lookupCache := true
+	if !data.LookupCache.IsNull() {
+		lookupCache = data.LookupCache.ValueBool()
+	}
+
+	providerData := &OctopusDeployProviderData{
+		Client:   client,
+		Cache:    NewLookupCache(lookupCache),
+		ReadOnly: readOnly,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *OctopusDeployProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAWSOIDCAccountResource,
+		NewChannelResource,
+		NewDeploymentProcessResource,
+		NewDeploymentTargetResource,
+		NewDynamicWorkerPoolResource,
+		NewEnvironmentResource,
+		NewFeedResource,
+		NewGitCredentialResource,
+		NewLibraryVariableSetResource,
+		NewLibraryVariableSetVariableResource,
+		NewLifecycleResource,
+		NewProjectResource,
+		NewProjectLibraryVariableSetResource,
 		NewProjectTriggerResource,
+		NewProjectVariableResource,
+		NewProjectVersionControlResource,
+		NewRunbookResource,
+		NewRunbookProcessResource,
 		NewServiceAccountOIDCIdentity,
+		NewStaticWorkerPoolResource,
+		NewTagSetResource,
+		NewTenantResource,
+		NewTenantCommonVariableResource,
 		NewTenantConnectionResource,
+		NewTenantProjectVariableResource,
+		NewWorkerResource,
 	}
 }
 
 func (p *OctopusDeployProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewChannelsDataSource,
+		NewDeploymentTargetsDataSource,
 		NewEnvironmentDataSource,
+		NewEnvironmentsDataSource,
+		NewFeedDataSource,
+		NewLifecycleDataSource,
 		NewProjectDataSource,
+		NewProjectVersionControlDataSource,
+		NewProjectsDataSource,
+		NewRunbookDataSource,
 		NewServiceAccountOIDCIdentities,
+		NewTagSetDataSource,
 		NewTenantDataSource,
+		NewTenantsDataSource,
+		NewWorkerPoolsDataSource,
 	}
 }
 
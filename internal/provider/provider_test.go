@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projectgroups"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"octopusdeploycontrib": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck skips the test unless TF_ACC is set, ensures the provider
+// can connect to Octopus, and returns the ID of a space other than the
+// provider's default space, which is read from the environment variable
+// OCTOPUSDEPLOY_TEST_SPACE_ID. It is called before the test steps are built,
+// as they refer to resources in that space.
+func testAccPreCheck(t *testing.T) (spaceID string) {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("acceptance tests are skipped unless %s is set", resource.EnvTfAcc)
+	}
+
+	for _, name := range []string{"OCTOPUSDEPLOY_SERVER_URL", "OCTOPUSDEPLOY_API_KEY", "OCTOPUSDEPLOY_SPACE_ID", "OCTOPUSDEPLOY_TEST_SPACE_ID"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+
+	spaceID = os.Getenv("OCTOPUSDEPLOY_TEST_SPACE_ID")
+	if spaceID == os.Getenv("OCTOPUSDEPLOY_SPACE_ID") {
+		t.Fatal("OCTOPUSDEPLOY_TEST_SPACE_ID must not be the provider's default space")
+	}
+
+	return spaceID
+}
+
+// testAccClient returns a client for the Octopus server under test.
+func testAccClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	uri, err := url.Parse(os.Getenv("OCTOPUSDEPLOY_SERVER_URL"))
+	if err != nil {
+		t.Fatalf("failed to parse OCTOPUSDEPLOY_SERVER_URL: %s", err)
+	}
+
+	octopus, err := client.NewClient(nil, uri, os.Getenv("OCTOPUSDEPLOY_API_KEY"), os.Getenv("OCTOPUSDEPLOY_SPACE_ID"))
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	return octopus
+}
+
+// testAccProjectGroupID returns the ID of a project group in the space, which
+// cannot be created by this provider.
+func testAccProjectGroupID(t *testing.T, spaceID string) string {
+	t.Helper()
+
+	groups, err := projectgroups.GetAll(testAccClient(t), spaceID)
+	if err != nil {
+		t.Fatalf("failed to list project groups in %s: %s", spaceID, err)
+	}
+
+	if len(groups) < 1 {
+		t.Fatalf("space %s has no project groups", spaceID)
+	}
+
+	return groups[0].ID
+}
+
+// testAccImportIDInSpace returns the import ID of a resource in another space,
+// which is the values of the attributes joined by colons and prefixed with the
+// space ID.
+func testAccImportIDInSpace(resourceName, spaceID string, attributes ...string) func(*terraform.State) (string, error) {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		values := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			values = append(values, rs.Primary.Attributes[attribute])
+		}
+
+		return spaceID + "/" + strings.Join(values, ":"), nil
+	}
+}
+
+// testResourceConfig returns the configuration of a resource with the given
+// top-level attribute values, leaving every other attribute null.
+func testResourceConfig(t *testing.T, r fwresource.Resource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	res := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, res)
+	if res.Diagnostics.HasError() {
+		t.Fatalf("failed to get schema: %v", res.Diagnostics)
+	}
+
+	objectType, ok := res.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tfsdk.Config{Schema: res.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
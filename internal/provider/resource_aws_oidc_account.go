@@ -35,7 +35,8 @@ func NewAWSOIDCAccountResource() resource.Resource {
 
 // AWSOIDCAccountResource defines the resource implementation.
 type AWSOIDCAccountResource struct {
-	client *client.Client
+	client   *client.Client
+	readOnly bool
 }
 
 // AWSOIDCAccountResourceModel describes the resource data model.
@@ -167,7 +168,7 @@ func (r *AWSOIDCAccountResource) Schema(ctx context.Context, req resource.Schema
 				Description:   "The space ID.",
 				Computed:      true,
 				Optional:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"id": schema.StringAttribute{
 				Description:   "The ID of the account.",
@@ -258,13 +259,14 @@ func (r *AWSOIDCAccountResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
 }
 
 func (r *AWSOIDCAccountResource) ConfigValidators(context.Context) []resource.ConfigValidator {
@@ -272,6 +274,11 @@ func (r *AWSOIDCAccountResource) ConfigValidators(context.Context) []resource.Co
 }
 
 func (r *AWSOIDCAccountResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create account"))
+		return
+	}
+
 	var plan AWSOIDCAccountResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
@@ -282,13 +289,11 @@ func (r *AWSOIDCAccountResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	if resource.SpaceID == "" {
-		resource.SpaceID = r.client.GetSpaceID()
-	}
+	resource.SpaceID = resolveSpaceID(r.client, resource.SpaceID)
 
 	tflog.Debug(ctx, "creating resource", map[string]interface{}{"resource": fmt.Sprintf("%#v", resource), "plan": fmt.Sprintf("%#v", plan)})
 
-	resource, err := custom.NewClient(r.client).CreateAWSOIDCAccount(ctx, *resource)
+	resource, err := custom.NewClient(r.client, r.readOnly).CreateAWSOIDCAccount(ctx, *resource)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create resource", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -312,11 +317,11 @@ func (r *AWSOIDCAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	resourceID := state.ID.ValueString()
-	spaceID := state.SpaceID.ValueString()
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
 
-	tflog.Debug(ctx, "fetching resource", map[string]interface{}{"id": resourceID})
+	tflog.Debug(ctx, "fetching resource", map[string]interface{}{"id": resourceID, "space_id": spaceID})
 
-	resource, err := custom.NewClient(r.client).GetAWSOIDCAccount(ctx, spaceID, resourceID)
+	resource, err := custom.NewClient(r.client, r.readOnly).GetAWSOIDCAccount(ctx, spaceID, resourceID)
 	if isAPIErrorNotFound(err) {
 		res.State.RemoveResource(ctx)
 		return
@@ -339,6 +344,11 @@ func (r *AWSOIDCAccountResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *AWSOIDCAccountResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update account"))
+		return
+	}
+
 	var plan AWSOIDCAccountResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
@@ -349,9 +359,11 @@ func (r *AWSOIDCAccountResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resource.SpaceID = resolveSpaceID(r.client, resource.SpaceID)
+
 	tflog.Debug(ctx, "updating resource", map[string]interface{}{"resource": resource})
 
-	resource, err := custom.NewClient(r.client).UpdateAWSOIDCAccount(ctx, *resource)
+	resource, err := custom.NewClient(r.client, r.readOnly).UpdateAWSOIDCAccount(ctx, *resource)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update resource", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -369,6 +381,11 @@ func (r *AWSOIDCAccountResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *AWSOIDCAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete account"))
+		return
+	}
+
 	var state AWSOIDCAccountResourceModel
 	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
 		return
@@ -379,9 +396,11 @@ func (r *AWSOIDCAccountResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	resource.SpaceID = resolveSpaceID(r.client, resource.SpaceID)
+
 	tflog.Debug(ctx, "deleting resource", map[string]interface{}{"resource": resource})
 
-	err := custom.NewClient(r.client).DeleteAWSOIDCAccount(ctx, resource.SpaceID, resource.ID)
+	err := custom.NewClient(r.client, r.readOnly).DeleteAWSOIDCAccount(ctx, resource.SpaceID, resource.ID)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete resource", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -390,9 +409,12 @@ func (r *AWSOIDCAccountResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *AWSOIDCAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	tflog.Debug(ctx, "importing resource", map[string]interface{}{"resource_id": req.ID})
+	spaceID, resourceID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing resource", map[string]interface{}{"resource_id": resourceID, "space_id": spaceID})
 
-	resource, err := custom.NewClient(r.client).GetAWSOIDCAccount(ctx, r.client.GetSpaceID(), req.ID)
+	resource, err := custom.NewClient(r.client, r.readOnly).GetAWSOIDCAccount(ctx, spaceID, resourceID)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get resource", err)...); res.Diagnostics.HasError() {
 		return
 	}
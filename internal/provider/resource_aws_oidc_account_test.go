@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAWSOIDCAccountResourceInSpace(t *testing.T) {
+	spaceID := testAccPreCheck(t)
+	name := "octopusdeploycontrib_aws_oidc_account.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSOIDCAccountResourceConfig(spaceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "space_id", spaceID),
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "role_arn", "arn:aws:iam::000000000000:role/acceptance-test"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDInSpace(name, spaceID, "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSOIDCAccountResourceConfig(spaceID string) string {
+	return fmt.Sprintf(`
+resource "octopusdeploycontrib_aws_oidc_account" "test" {
+  space_id                          = %[1]q
+  name                              = "acceptance-test"
+  role_arn                          = "arn:aws:iam::000000000000:role/acceptance-test"
+  tenanted_deployment_participation = "Untenanted"
+}
+`, spaceID)
+}
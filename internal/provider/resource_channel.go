@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*ChannelResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ChannelResource)(nil)
+	_ resource.ResourceWithImportState = (*ChannelResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*ChannelResource)(nil)
+)
+
+func NewChannelResource() resource.Resource {
+	return &ChannelResource{}
+}
+
+// ChannelResource defines the resource implementation.
+type ChannelResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// ChannelResourceModel describes the resource data model.
+type ChannelResourceModel struct {
+	SpaceID     types.String               `tfsdk:"space_id"`
+	ID          types.String               `tfsdk:"id"`
+	ProjectID   types.String               `tfsdk:"project_id"`
+	Name        types.String               `tfsdk:"name"`
+	Description types.String               `tfsdk:"description"`
+	LifecycleID types.String               `tfsdk:"lifecycle_id"`
+	IsDefault   types.Bool                 `tfsdk:"is_default"`
+	TenantTags  types.Set                  `tfsdk:"tenant_tags"`
+	Rules       []ChannelRuleResourceModel `tfsdk:"rule"`
+}
+
+// ChannelRuleResourceModel describes a version rule of a channel.
+type ChannelRuleResourceModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	ActionPackages []ChannelRuleActionPackageModel `tfsdk:"action_packages"`
+	VersionRange   types.String                    `tfsdk:"version_range"`
+	Tag            types.String                    `tfsdk:"tag"`
+}
+
+// ChannelRuleActionPackageModel describes a package referenced by a step,
+// which a channel rule applies to.
+type ChannelRuleActionPackageModel struct {
+	DeploymentAction types.String `tfsdk:"deployment_action"`
+	PackageReference types.String `tfsdk:"package_reference"`
+}
+
+// ruleKey identifies a rule by its content, so unchanged rules keep their ID
+// when they are moved.
+func (m ChannelRuleResourceModel) ruleKey() (id, name types.String) {
+	if m.VersionRange.IsUnknown() || m.Tag.IsUnknown() {
+		return m.ID, types.StringUnknown()
+	}
+
+	return m.ID, types.StringValue(m.VersionRange.ValueString() + "\n" + m.Tag.ValueString())
+}
+
+// expandChannelResourceModel converts the model to a resource.
+func expandChannelResourceModel(ctx context.Context, model ChannelResourceModel) (*channels.Channel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource := &channels.Channel{
+		SpaceID:     model.SpaceID.ValueString(),
+		Resource:    *resources.NewResource(),
+		ProjectID:   model.ProjectID.ValueString(),
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		LifecycleID: model.LifecycleID.ValueString(),
+		IsDefault:   model.IsDefault.ValueBool(),
+		Rules:       []channels.ChannelRule{},
+	}
+	resource.ID = model.ID.ValueString()
+
+	var nestedDiags diag.Diagnostics
+	resource.TenantTags, nestedDiags = expandStringSet(ctx, model.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	for _, rule := range model.Rules {
+		item := channels.ChannelRule{
+			ID:             rule.ID.ValueString(),
+			VersionRange:   rule.VersionRange.ValueString(),
+			Tag:            rule.Tag.ValueString(),
+			ActionPackages: []packages.DeploymentActionPackage{},
+		}
+
+		for _, actionPackage := range rule.ActionPackages {
+			item.ActionPackages = append(item.ActionPackages, packages.DeploymentActionPackage{
+				DeploymentAction: actionPackage.DeploymentAction.ValueString(),
+				PackageReference: actionPackage.PackageReference.ValueString(),
+			})
+		}
+
+		resource.Rules = append(resource.Rules, item)
+	}
+
+	return resource, diags
+}
+
+// flattenChannelResourceModel converts the resource to a model.
+func flattenChannelResourceModel(ctx context.Context, resource *channels.Channel) (*ChannelResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ChannelResourceModel{
+		SpaceID:     types.StringValue(resource.SpaceID),
+		ID:          types.StringValue(resource.ID),
+		ProjectID:   types.StringValue(resource.ProjectID),
+		Name:        types.StringValue(resource.Name),
+		Description: types.StringValue(resource.Description),
+		LifecycleID: types.StringNull(),
+		IsDefault:   types.BoolValue(resource.IsDefault),
+		Rules:       []ChannelRuleResourceModel{},
+	}
+
+	if resource.LifecycleID != "" {
+		model.LifecycleID = types.StringValue(resource.LifecycleID)
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.TenantTags, nestedDiags = flattenStringSet(ctx, resource.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	for _, rule := range resource.Rules {
+		item := ChannelRuleResourceModel{
+			ID:             types.StringValue(rule.ID),
+			VersionRange:   types.StringValue(rule.VersionRange),
+			Tag:            types.StringValue(rule.Tag),
+			ActionPackages: []ChannelRuleActionPackageModel{},
+		}
+
+		for _, actionPackage := range rule.ActionPackages {
+			item.ActionPackages = append(item.ActionPackages, ChannelRuleActionPackageModel{
+				DeploymentAction: types.StringValue(actionPackage.DeploymentAction),
+				PackageReference: types.StringValue(actionPackage.PackageReference),
+			})
+		}
+
+		model.Rules = append(model.Rules, item)
+	}
+
+	return &model, diags
+}
+
+func (r *ChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (r *ChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a channel of a project, and the version rules of the packages it releases",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the channel belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the channel",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project that the channel belongs to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the channel",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the channel",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"lifecycle_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the lifecycle used by releases in the channel, inherited from the project when not set",
+				Optional:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the channel is the project's default channel. Making a channel the default replaces the previous default",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tenant_tags": schema.SetAttribute{
+				MarkdownDescription: "Canonical names of the tags of tenants which can be deployed to in the channel, in the form `TagSet/Tag`",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "Version rules which packages must satisfy to be released in the channel. Rules keep their ID when moved, or when changed without being moved",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the rule",
+							Computed:            true,
+						},
+						"action_packages": schema.SetNestedAttribute{
+							MarkdownDescription: "The packages the rule applies to",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"deployment_action": schema.StringAttribute{
+										MarkdownDescription: "The name of the step action referencing the package",
+										Required:            true,
+									},
+									"package_reference": schema.StringAttribute{
+										MarkdownDescription: "The name of the package reference, empty for the action's primary package",
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString(""),
+									},
+								},
+							},
+						},
+						"version_range": schema.StringAttribute{
+							MarkdownDescription: "The NuGet version range which package versions must be in, such as `[1.0,2.0)`",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+							Validators:          []validator.String{validNuGetVersionRange()},
+						},
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "A regular expression which the pre-release tag of package versions must match, such as `^$` for stable versions only",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fills in the IDs of rules which already exist, so reordering
+// rules only shows the moved rules in the plan.
+func (r *ChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ChannelResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []ChannelRuleResourceModel
+	if !req.State.Raw.IsNull() {
+		var state ChannelResourceModel
+		if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		existing = state.Rules
+	}
+
+	ids := matchNestedIDs(plan.Rules, existing, ChannelRuleResourceModel.ruleKey)
+	for i := range plan.Rules {
+		plan.Rules[i].ID = types.StringUnknown()
+		if !ids[i].IsNull() {
+			plan.Rules[i].ID = ids[i]
+		}
+	}
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create channel"))
+		return
+	}
+
+	var plan ChannelResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := expandChannelResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	channel.SpaceID = resolveSpaceID(r.client, channel.SpaceID)
+	defer r.cache.invalidate(channel.SpaceID, "channels")
+
+	tflog.Debug(ctx, "creating channel", map[string]interface{}{"channel": channel})
+
+	channel, err := channels.Add(r.client, channel)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create channel", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created channel", map[string]interface{}{"channel": channel})
+
+	model, diags := flattenChannelResourceModel(ctx, channel)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state ChannelResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	channelID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching channel", map[string]interface{}{"id": channelID, "space_id": spaceID})
+
+	channel, err := channels.GetByID(r.client, spaceID, channelID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get channel", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched channel", map[string]interface{}{"channel": channel})
+
+	model, diags := flattenChannelResourceModel(ctx, channel)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update channel"))
+		return
+	}
+
+	var plan ChannelResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := expandChannelResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	channel.SpaceID = resolveSpaceID(r.client, channel.SpaceID)
+	defer r.cache.invalidate(channel.SpaceID, "channels")
+
+	tflog.Debug(ctx, "updating channel", map[string]interface{}{"channel": channel})
+
+	channel, err := channels.Update(r.client, channel)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update channel", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated channel", map[string]interface{}{"channel": channel})
+
+	model, diags := flattenChannelResourceModel(ctx, channel)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete channel"))
+		return
+	}
+
+	var state ChannelResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "channels")
+	channelID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting channel", map[string]interface{}{"id": channelID, "space_id": spaceID})
+
+	err := channels.DeleteByID(r.client, spaceID, channelID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete channel", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted channel", map[string]interface{}{"id": channelID})
+}
+
+func (r *ChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, channelID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing channel", map[string]interface{}{"id": channelID, "space_id": spaceID})
+
+	channel, err := channels.GetByID(r.client, spaceID, channelID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Channel not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get channel", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported channel", map[string]interface{}{"channel": channel})
+
+	model, diags := flattenChannelResourceModel(ctx, channel)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*DeploymentProcessResource)(nil)
+	_ resource.ResourceWithConfigure   = (*DeploymentProcessResource)(nil)
+	_ resource.ResourceWithImportState = (*DeploymentProcessResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*DeploymentProcessResource)(nil)
+)
+
+func NewDeploymentProcessResource() resource.Resource {
+	return &DeploymentProcessResource{}
+}
+
+// DeploymentProcessResource defines the resource implementation.
+type DeploymentProcessResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// DeploymentProcessResourceModel describes the resource data model.
+type DeploymentProcessResourceModel struct {
+	SpaceID   types.String       `tfsdk:"space_id"`
+	ID        types.String       `tfsdk:"id"`
+	ProjectID types.String       `tfsdk:"project_id"`
+	Version   types.Int64        `tfsdk:"version"`
+	Steps     []ProcessStepModel `tfsdk:"steps"`
+}
+
+// flattenDeploymentProcessResourceModel converts the process to a model, with
+// the properties owned by the prior steps.
+func flattenDeploymentProcessResourceModel(ctx context.Context, process *deployments.DeploymentProcess, prior []ProcessStepModel, isImport bool) (*DeploymentProcessResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := DeploymentProcessResourceModel{
+		SpaceID:   types.StringValue(process.SpaceID),
+		ID:        types.StringValue(process.ID),
+		ProjectID: types.StringValue(process.ProjectID),
+		Version:   types.Int64Value(int64(process.Version)),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.Steps, nestedDiags = flattenProcessSteps(ctx, process.Steps, prior, isImport)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+func (r *DeploymentProcessResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_deployment_process"
+}
+
+func (r *DeploymentProcessResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the deployment process of a project whose configuration is stored in the database. Destroying the resource removes every step from the process",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the project belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the deployment process",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the process, incremented by Octopus whenever it is changed",
+				Computed:            true,
+			},
+			"steps": processStepsResourceAttribute(),
+		},
+	}
+}
+
+func (r *DeploymentProcessResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fills in the IDs of steps and actions which already exist, so
+// reordering steps keeps their IDs and only shows the moved steps in the plan.
+func (r *DeploymentProcessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DeploymentProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []ProcessStepModel
+	if !req.State.Raw.IsNull() {
+		var state DeploymentProcessResourceModel
+		if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		existing = state.Steps
+	}
+
+	matchProcessStepIDs(plan.Steps, existing)
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// getProjectDeploymentProcess fetches the deployment process of a project,
+// which must not be version controlled.
+func getProjectDeploymentProcess(client *client.Client, spaceID, projectID string) (*deployments.DeploymentProcess, error) {
+	project, err := projects.GetByID(client, spaceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.IsVersionControlled {
+		return nil, fmt.Errorf("project %s is version controlled, so its deployment process is stored in git", projectID)
+	}
+
+	return deployments.GetDeploymentProcessByID(client, spaceID, project.DeploymentProcessID)
+}
+
+// apply writes the planned steps to the deployment process of the project.
+func (r *DeploymentProcessResource) apply(ctx context.Context, plan DeploymentProcessResourceModel, prior []ProcessStepModel) (*DeploymentProcessResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	projectID := plan.ProjectID.ValueString()
+
+	defer lockResource(spaceID, "deploymentprocess", projectID)()
+
+	process, err := getProjectDeploymentProcess(r.client, spaceID, projectID)
+	if diags.Append(ErrAsDiagnostic("Failed to get deployment process", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	var nestedDiags diag.Diagnostics
+	process.Steps, nestedDiags = expandProcessSteps(ctx, plan.Steps, prior, process.Steps)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "updating deployment process", map[string]interface{}{"process": process})
+
+	process, err = deployments.UpdateDeploymentProcess(r.client, process)
+	if diags.Append(ErrAsDiagnostic("Failed to update deployment process", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "updated deployment process", map[string]interface{}{"process": process})
+
+	return flattenDeploymentProcessResourceModel(ctx, process, plan.Steps, false)
+}
+
+func (r *DeploymentProcessResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create deployment process"))
+		return
+	}
+
+	var plan DeploymentProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentProcessResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state DeploymentProcessResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	processID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching deployment process", map[string]interface{}{"id": processID, "space_id": spaceID})
+
+	process, err := deployments.GetDeploymentProcessByID(r.client, spaceID, processID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get deployment process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched deployment process", map[string]interface{}{"process": process})
+
+	model, diags := flattenDeploymentProcessResourceModel(ctx, process, state.Steps, false)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentProcessResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update deployment process"))
+		return
+	}
+
+	var plan, state DeploymentProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan, state.Steps)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentProcessResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete deployment process"))
+		return
+	}
+
+	var state DeploymentProcessResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	projectID := state.ProjectID.ValueString()
+	processID := state.ID.ValueString()
+
+	defer lockResource(spaceID, "deploymentprocess", projectID)()
+
+	process, err := deployments.GetDeploymentProcessByID(r.client, spaceID, processID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get deployment process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removing deployment process steps", map[string]interface{}{"id": processID, "space_id": spaceID})
+
+	process.Steps = []*deployments.DeploymentStep{}
+	_, err = deployments.UpdateDeploymentProcess(r.client, process)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update deployment process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removed deployment process steps", map[string]interface{}{"id": processID})
+}
+
+func (r *DeploymentProcessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing deployment process", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	// the process can be imported by its own ID or by the project's ID
+	projectID := strings.TrimPrefix(id, "deploymentprocess-")
+
+	process, err := getProjectDeploymentProcess(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Project not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get deployment process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported deployment process", map[string]interface{}{"process": process})
+
+	model, diags := flattenDeploymentProcessResourceModel(ctx, process, nil, true)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
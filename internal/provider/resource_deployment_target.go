@@ -0,0 +1,688 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = (*DeploymentTargetResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*DeploymentTargetResource)(nil)
+	_ resource.ResourceWithConfigure        = (*DeploymentTargetResource)(nil)
+	_ resource.ResourceWithImportState      = (*DeploymentTargetResource)(nil)
+)
+
+// kubernetesAuthenticationTypeAWS authenticates with an EKS cluster using an
+// AWS account.
+const kubernetesAuthenticationTypeAWS = "KubernetesAws"
+
+func NewDeploymentTargetResource() resource.Resource {
+	return &DeploymentTargetResource{}
+}
+
+// DeploymentTargetResource defines the resource implementation.
+type DeploymentTargetResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// DeploymentTargetResourceModel describes the resource data model. Exactly one
+// of the endpoint-specific attributes is set.
+type DeploymentTargetResourceModel struct {
+	SpaceID                         types.String                      `tfsdk:"space_id"`
+	ID                              types.String                      `tfsdk:"id"`
+	Name                            types.String                      `tfsdk:"name"`
+	EnvironmentIDs                  types.Set                         `tfsdk:"environment_ids"`
+	Roles                           types.Set                         `tfsdk:"roles"`
+	TenantedDeploymentParticipation types.String                      `tfsdk:"tenanted_deployment_participation"`
+	TenantIDs                       types.Set                         `tfsdk:"tenant_ids"`
+	TenantTags                      types.Set                         `tfsdk:"tenant_tags"`
+	MachinePolicyID                 types.String                      `tfsdk:"machine_policy_id"`
+	IsDisabled                      types.Bool                        `tfsdk:"is_disabled"`
+	ListeningTentacle               *TentacleDeploymentTargetModel    `tfsdk:"listening_tentacle"`
+	PollingTentacle                 *TentacleDeploymentTargetModel    `tfsdk:"polling_tentacle"`
+	Kubernetes                      *KubernetesDeploymentTargetModel  `tfsdk:"kubernetes"`
+	CloudRegion                     *CloudRegionDeploymentTargetModel `tfsdk:"cloud_region"`
+	OfflineDrop                     *OfflineDropDeploymentTargetModel `tfsdk:"offline_drop"`
+}
+
+// TentacleDeploymentTargetModel describes a listening or polling Tentacle.
+type TentacleDeploymentTargetModel struct {
+	URI        types.String `tfsdk:"uri"`
+	Thumbprint types.String `tfsdk:"thumbprint"`
+}
+
+type KubernetesDeploymentTargetModel struct {
+	ClusterURL           types.String `tfsdk:"cluster_url"`
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	AWSAccountID         types.String `tfsdk:"aws_account_id"`
+	Namespace            types.String `tfsdk:"namespace"`
+	SkipTLSVerification  types.Bool   `tfsdk:"skip_tls_verification"`
+	ClusterCertificateID types.String `tfsdk:"cluster_certificate_id"`
+	DefaultWorkerPoolID  types.String `tfsdk:"default_worker_pool_id"`
+}
+
+type CloudRegionDeploymentTargetModel struct {
+	DefaultWorkerPoolID types.String `tfsdk:"default_worker_pool_id"`
+}
+
+type OfflineDropDeploymentTargetModel struct {
+	DestinationType                      types.String `tfsdk:"destination_type"`
+	DropFolderPath                       types.String `tfsdk:"drop_folder_path"`
+	ApplicationsDirectory                types.String `tfsdk:"applications_directory"`
+	WorkingDirectory                     types.String `tfsdk:"working_directory"`
+	SensitiveVariablesEncryptionPassword types.String `tfsdk:"sensitive_variables_encryption_password"`
+}
+
+// sensitiveVariablesEncryptionPassword returns the password of the offline
+// drop, or null when there is no offline drop.
+func (m *OfflineDropDeploymentTargetModel) sensitiveVariablesEncryptionPassword() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.SensitiveVariablesEncryptionPassword
+}
+
+// expandDeploymentTargetResourceModel converts the model to a deployment
+// target.
+func expandDeploymentTargetResourceModel(ctx context.Context, model DeploymentTargetResourceModel) (*custom.DeploymentTarget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deploymentTarget := custom.DeploymentTarget{
+		SpaceID:                         model.SpaceID.ValueString(),
+		ID:                              model.ID.ValueString(),
+		Name:                            model.Name.ValueString(),
+		TenantedDeploymentParticipation: model.TenantedDeploymentParticipation.ValueString(),
+		IsDisabled:                      model.IsDisabled.ValueBool(),
+	}
+
+	// the space's default policy is assigned until one is known
+	if !model.MachinePolicyID.IsUnknown() {
+		deploymentTarget.MachinePolicyID = model.MachinePolicyID.ValueString()
+	}
+
+	var nestedDiags diag.Diagnostics
+	deploymentTarget.EnvironmentIDs, nestedDiags = expandStringSet(ctx, model.EnvironmentIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	deploymentTarget.Roles, nestedDiags = expandStringSet(ctx, model.Roles)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	deploymentTarget.TenantIDs, nestedDiags = expandStringSet(ctx, model.TenantIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	deploymentTarget.TenantTags, nestedDiags = expandStringSet(ctx, model.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case model.ListeningTentacle != nil:
+		deploymentTarget.URI = model.ListeningTentacle.URI.ValueString()
+		deploymentTarget.Thumbprint = model.ListeningTentacle.Thumbprint.ValueString()
+		deploymentTarget.Endpoint = expandTentacleEndpoint("Listening", model.ListeningTentacle.URI, model.ListeningTentacle.Thumbprint)
+
+	case model.PollingTentacle != nil:
+		deploymentTarget.URI = model.PollingTentacle.URI.ValueString()
+		deploymentTarget.Thumbprint = model.PollingTentacle.Thumbprint.ValueString()
+		deploymentTarget.Endpoint = expandTentacleEndpoint("Polling", model.PollingTentacle.URI, model.PollingTentacle.Thumbprint)
+
+	case model.Kubernetes != nil:
+		deploymentTarget.Endpoint = &custom.MachineEndpoint{
+			CommunicationStyle:  "Kubernetes",
+			ClusterURL:          model.Kubernetes.ClusterURL.ValueString(),
+			Namespace:           model.Kubernetes.Namespace.ValueString(),
+			SkipTLSVerification: model.Kubernetes.SkipTLSVerification.ValueBool(),
+			ClusterCertificate:  model.Kubernetes.ClusterCertificateID.ValueString(),
+			DefaultWorkerPoolID: model.Kubernetes.DefaultWorkerPoolID.ValueString(),
+			Authentication: &custom.KubernetesAuthentication{
+				AuthenticationType: kubernetesAuthenticationTypeAWS,
+				AccountID:          model.Kubernetes.AWSAccountID.ValueString(),
+				ClusterName:        model.Kubernetes.ClusterName.ValueString(),
+			},
+		}
+
+	case model.CloudRegion != nil:
+		deploymentTarget.Endpoint = &custom.MachineEndpoint{
+			CommunicationStyle:  "None",
+			DefaultWorkerPoolID: model.CloudRegion.DefaultWorkerPoolID.ValueString(),
+		}
+
+	case model.OfflineDrop != nil:
+		deploymentTarget.Endpoint = &custom.MachineEndpoint{
+			CommunicationStyle:                   "OfflineDrop",
+			ApplicationsDirectory:                model.OfflineDrop.ApplicationsDirectory.ValueString(),
+			WorkingDirectory:                     model.OfflineDrop.WorkingDirectory.ValueString(),
+			SensitiveVariablesEncryptionPassword: core.NewSensitiveValue(model.OfflineDrop.SensitiveVariablesEncryptionPassword.ValueString()),
+			Destination: &custom.OfflineDropDestination{
+				DestinationType: model.OfflineDrop.DestinationType.ValueString(),
+				DropFolderPath:  model.OfflineDrop.DropFolderPath.ValueString(),
+			},
+		}
+	}
+
+	return &deploymentTarget, diags
+}
+
+// flattenDeploymentTargetResourceModel converts the deployment target to a
+// model. The offline drop password is carried over from the prior model, which
+// is nil on import.
+func flattenDeploymentTargetResourceModel(ctx context.Context, deploymentTarget *custom.DeploymentTarget, prior *DeploymentTargetResourceModel) (*DeploymentTargetResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior == nil {
+		prior = &DeploymentTargetResourceModel{}
+	}
+
+	model := DeploymentTargetResourceModel{
+		SpaceID:                         types.StringValue(deploymentTarget.SpaceID),
+		ID:                              types.StringValue(deploymentTarget.ID),
+		Name:                            types.StringValue(deploymentTarget.Name),
+		TenantedDeploymentParticipation: types.StringValue(deploymentTarget.TenantedDeploymentParticipation),
+		MachinePolicyID:                 types.StringValue(deploymentTarget.MachinePolicyID),
+		IsDisabled:                      types.BoolValue(deploymentTarget.IsDisabled),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.EnvironmentIDs, nestedDiags = flattenStringSet(ctx, deploymentTarget.EnvironmentIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.Roles, nestedDiags = flattenStringSet(ctx, deploymentTarget.Roles)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.TenantIDs, nestedDiags = flattenStringSet(ctx, deploymentTarget.TenantIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.TenantTags, nestedDiags = flattenStringSet(ctx, deploymentTarget.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	endpoint := deploymentTarget.Endpoint
+	if endpoint == nil {
+		endpoint = &custom.MachineEndpoint{}
+	}
+
+	switch endpoint.CommunicationStyle {
+	case tentacleCommunicationStyles["Listening"]:
+		model.ListeningTentacle = &TentacleDeploymentTargetModel{
+			URI:        types.StringValue(endpoint.URI),
+			Thumbprint: types.StringValue(endpoint.Thumbprint),
+		}
+
+	case tentacleCommunicationStyles["Polling"]:
+		model.PollingTentacle = &TentacleDeploymentTargetModel{
+			URI:        types.StringValue(endpoint.URI),
+			Thumbprint: types.StringValue(endpoint.Thumbprint),
+		}
+
+	case "Kubernetes":
+		if endpoint.Authentication == nil || endpoint.Authentication.AuthenticationType != kubernetesAuthenticationTypeAWS || endpoint.Authentication.UseInstanceRole || endpoint.Authentication.AssumeRole {
+			diags.AddError("Unsupported deployment target", fmt.Sprintf("Kubernetes cluster %s does not authenticate with an AWS account, which is the only authentication managed by this resource", deploymentTarget.ID))
+			return nil, diags
+		}
+
+		model.Kubernetes = &KubernetesDeploymentTargetModel{
+			ClusterURL:           types.StringValue(endpoint.ClusterURL),
+			ClusterName:          types.StringValue(endpoint.Authentication.ClusterName),
+			AWSAccountID:         types.StringValue(endpoint.Authentication.AccountID),
+			Namespace:            flattenOptionalString(endpoint.Namespace),
+			SkipTLSVerification:  types.BoolValue(endpoint.SkipTLSVerification),
+			ClusterCertificateID: flattenOptionalString(endpoint.ClusterCertificate),
+			DefaultWorkerPoolID:  flattenOptionalString(endpoint.DefaultWorkerPoolID),
+		}
+
+	case "None":
+		model.CloudRegion = &CloudRegionDeploymentTargetModel{
+			DefaultWorkerPoolID: flattenOptionalString(endpoint.DefaultWorkerPoolID),
+		}
+
+	case "OfflineDrop":
+		destination := endpoint.Destination
+		if destination == nil {
+			destination = &custom.OfflineDropDestination{DestinationType: "Artifact"}
+		}
+
+		// Octopus never returns the password, so the prior value is kept while one is set
+		password := types.StringNull()
+		if endpoint.SensitiveVariablesEncryptionPassword != nil && endpoint.SensitiveVariablesEncryptionPassword.HasValue {
+			password = prior.OfflineDrop.sensitiveVariablesEncryptionPassword()
+		}
+
+		model.OfflineDrop = &OfflineDropDeploymentTargetModel{
+			DestinationType:                      types.StringValue(destination.DestinationType),
+			DropFolderPath:                       flattenOptionalString(destination.DropFolderPath),
+			ApplicationsDirectory:                types.StringValue(endpoint.ApplicationsDirectory),
+			WorkingDirectory:                     types.StringValue(endpoint.WorkingDirectory),
+			SensitiveVariablesEncryptionPassword: password,
+		}
+
+	default:
+		diags.AddError("Unsupported deployment target", fmt.Sprintf("deployment target %s has the communication style %s, which is not managed by this resource", deploymentTarget.ID, endpoint.CommunicationStyle))
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// requiresReplaceIfDeploymentTargetTypeChanges replaces the deployment target
+// when its endpoint-specific attribute is added or removed, as Octopus cannot
+// change how it communicates with a deployment target.
+func requiresReplaceIfDeploymentTargetTypeChanges() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, res *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			res.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		"The deployment target is replaced when its type changes",
+		"The deployment target is replaced when its type changes",
+	)
+}
+
+func tentacleDeploymentTargetAttributes(uriDescription string, scheme string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"uri": schema.StringAttribute{
+			MarkdownDescription: uriDescription,
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.RegexMatches(regexp.MustCompile("^"+regexp.QuoteMeta(scheme)), fmt.Sprintf("must start with %s", scheme))},
+		},
+		"thumbprint": schema.StringAttribute{
+			MarkdownDescription: "The thumbprint of the Tentacle's certificate",
+			Required:            true,
+		},
+	}
+}
+
+func defaultWorkerPoolIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the worker pool that runs steps targeting the deployment target. The space's default worker pool is used when not set",
+		Optional:            true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+func (r *DeploymentTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_deployment_target"
+}
+
+func (r *DeploymentTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{}))
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to register a deployment target, which is one of a listening Tentacle, a polling Tentacle, a Kubernetes cluster authenticated with an AWS account, a cloud region or an offline drop",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the deployment target belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the deployment target",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the deployment target",
+				Required:            true,
+			},
+			"environment_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the environments the deployment target belongs to",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "Roles of the deployment target, which deployment process steps target",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"tenanted_deployment_participation": schema.StringAttribute{
+				MarkdownDescription: "Whether the deployment target is used in tenanted deployments, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Untenanted"),
+				Validators:          []validator.String{stringvalidator.OneOf("Tenanted", "TenantedOrUntenanted", "Untenanted")},
+			},
+			"tenant_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the tenants the deployment target is used for in tenanted deployments",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             emptySet,
+			},
+			"tenant_tags": schema.SetAttribute{
+				MarkdownDescription: "Canonical names of the tenant tags, in the form `TagSet/Tag`, whose tenants the deployment target is used for in tenanted deployments",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             emptySet,
+			},
+			"machine_policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the machine policy of the deployment target. The space's default policy is used when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the deployment target is disabled, which excludes it from deployments",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"listening_tentacle": schema.SingleNestedAttribute{
+				MarkdownDescription: "A Tentacle which Octopus connects to",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfDeploymentTargetTypeChanges()},
+				Attributes:          tentacleDeploymentTargetAttributes("The address of the Tentacle, such as `https://web-1:10933/`", "https://"),
+			},
+			"polling_tentacle": schema.SingleNestedAttribute{
+				MarkdownDescription: "A Tentacle which connects to Octopus",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfDeploymentTargetTypeChanges()},
+				Attributes:          tentacleDeploymentTargetAttributes("The subscription of the Tentacle, such as `poll://abcdefghijklmnopqrst/`", "poll://"),
+			},
+			"kubernetes": schema.SingleNestedAttribute{
+				MarkdownDescription: "An EKS cluster which Octopus authenticates with using an AWS account, such as an `octopusdeploycontrib_aws_oidc_account`",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfDeploymentTargetTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"cluster_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the cluster's API server",
+						Required:            true,
+					},
+					"cluster_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the EKS cluster",
+						Required:            true,
+					},
+					"aws_account_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the AWS account used to authenticate with the cluster",
+						Required:            true,
+					},
+					"namespace": schema.StringAttribute{
+						MarkdownDescription: "The default namespace of the cluster's resources",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"skip_tls_verification": schema.BoolAttribute{
+						MarkdownDescription: "Whether the certificate of the cluster's API server is trusted without verification",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"cluster_certificate_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the certificate used to verify the cluster's API server",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"default_worker_pool_id": defaultWorkerPoolIDAttribute(),
+				},
+			},
+			"cloud_region": schema.SingleNestedAttribute{
+				MarkdownDescription: "A cloud region, which runs steps on a worker",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfDeploymentTargetTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"default_worker_pool_id": defaultWorkerPoolIDAttribute(),
+				},
+			},
+			"offline_drop": schema.SingleNestedAttribute{
+				MarkdownDescription: "An offline package drop, which bundles a deployment to be run by hand",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfDeploymentTargetTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"destination_type": schema.StringAttribute{
+						MarkdownDescription: "Where the bundle is written, either `Artifact` to attach it to the deployment or `FileSystem` to write it to `drop_folder_path`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("Artifact"),
+						Validators:          []validator.String{stringvalidator.OneOf("Artifact", "FileSystem")},
+					},
+					"drop_folder_path": schema.StringAttribute{
+						MarkdownDescription: "The folder the bundle is written to when `destination_type` is `FileSystem`",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"applications_directory": schema.StringAttribute{
+						MarkdownDescription: "The directory that packages are extracted to when the bundle is run",
+						Required:            true,
+					},
+					"working_directory": schema.StringAttribute{
+						MarkdownDescription: "The working directory of Octopus when the bundle is run",
+						Required:            true,
+					},
+					"sensitive_variables_encryption_password": schema.StringAttribute{
+						MarkdownDescription: "The password used to encrypt sensitive variables in the bundle. Octopus never returns it, so changes made outside Terraform are not detected",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DeploymentTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *DeploymentTargetResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("listening_tentacle"),
+			path.MatchRoot("polling_tentacle"),
+			path.MatchRoot("kubernetes"),
+			path.MatchRoot("cloud_region"),
+			path.MatchRoot("offline_drop"),
+		),
+	}
+}
+
+func (r *DeploymentTargetResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create deployment target"))
+		return
+	}
+
+	var plan DeploymentTargetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentTarget, diags := expandDeploymentTargetResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentTarget.SpaceID = resolveSpaceID(r.client, deploymentTarget.SpaceID)
+
+	tflog.Debug(ctx, "creating deployment target", map[string]interface{}{"name": deploymentTarget.Name, "space_id": deploymentTarget.SpaceID})
+
+	deploymentTarget, err := custom.NewClient(r.client, r.readOnly).CreateDeploymentTarget(ctx, *deploymentTarget)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create deployment target", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created deployment target", map[string]interface{}{"id": deploymentTarget.ID})
+
+	model, diags := flattenDeploymentTargetResourceModel(ctx, deploymentTarget, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentTargetResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state DeploymentTargetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	deploymentTargetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching deployment target", map[string]interface{}{"id": deploymentTargetID, "space_id": spaceID})
+
+	deploymentTarget, err := custom.NewClient(r.client, r.readOnly).GetDeploymentTarget(ctx, spaceID, deploymentTargetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get deployment target", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched deployment target", map[string]interface{}{"id": deploymentTarget.ID})
+
+	model, diags := flattenDeploymentTargetResourceModel(ctx, deploymentTarget, &state)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentTargetResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update deployment target"))
+		return
+	}
+
+	var plan DeploymentTargetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentTarget, diags := expandDeploymentTargetResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentTarget.SpaceID = resolveSpaceID(r.client, deploymentTarget.SpaceID)
+
+	tflog.Debug(ctx, "updating deployment target", map[string]interface{}{"id": deploymentTarget.ID, "space_id": deploymentTarget.SpaceID})
+
+	deploymentTarget, err := custom.NewClient(r.client, r.readOnly).UpdateDeploymentTarget(ctx, *deploymentTarget)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update deployment target", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated deployment target", map[string]interface{}{"id": deploymentTarget.ID})
+
+	model, diags := flattenDeploymentTargetResourceModel(ctx, deploymentTarget, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete deployment target"))
+		return
+	}
+
+	var state DeploymentTargetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	deploymentTargetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting deployment target", map[string]interface{}{"id": deploymentTargetID, "space_id": spaceID})
+
+	err := custom.NewClient(r.client, r.readOnly).DeleteDeploymentTarget(ctx, spaceID, deploymentTargetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete deployment target", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted deployment target", map[string]interface{}{"id": deploymentTargetID})
+}
+
+func (r *DeploymentTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing deployment target", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	deploymentTarget, err := custom.NewClient(r.client, r.readOnly).GetDeploymentTarget(ctx, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Deployment target not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get deployment target", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := flattenDeploymentTargetResourceModel(ctx, deploymentTarget, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
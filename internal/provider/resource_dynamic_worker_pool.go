@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*DynamicWorkerPoolResource)(nil)
+	_ resource.ResourceWithConfigure   = (*DynamicWorkerPoolResource)(nil)
+	_ resource.ResourceWithImportState = (*DynamicWorkerPoolResource)(nil)
+)
+
+func NewDynamicWorkerPoolResource() resource.Resource {
+	return &DynamicWorkerPoolResource{}
+}
+
+// DynamicWorkerPoolResource defines the resource implementation.
+type DynamicWorkerPoolResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// DynamicWorkerPoolResourceModel describes the resource data model.
+type DynamicWorkerPoolResourceModel struct {
+	SpaceID     types.String `tfsdk:"space_id"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	SortOrder   types.Int64  `tfsdk:"sort_order"`
+	WorkerType  types.String `tfsdk:"worker_type"`
+}
+
+// expandDynamicWorkerPoolResourceModel applies the model to the worker pool.
+func expandDynamicWorkerPoolResourceModel(model DynamicWorkerPoolResourceModel, workerPool *workerpools.DynamicWorkerPool) {
+	expandWorkerPoolSettings(workerPool, model.SpaceID, model.ID, model.Name, model.Description, model.IsDefault, model.SortOrder)
+	workerPool.WorkerType = model.WorkerType.ValueString()
+}
+
+// flattenDynamicWorkerPoolResourceModel converts the resource to a model.
+func flattenDynamicWorkerPoolResourceModel(resource workerpools.IWorkerPool) *DynamicWorkerPoolResourceModel {
+	model := &DynamicWorkerPoolResourceModel{
+		SpaceID:     types.StringValue(resource.GetSpaceID()),
+		ID:          types.StringValue(resource.GetID()),
+		Name:        types.StringValue(resource.GetName()),
+		Description: types.StringValue(resource.GetDescription()),
+		IsDefault:   types.BoolValue(resource.GetIsDefault()),
+		SortOrder:   types.Int64Value(int64(resource.GetSortOrder())),
+		WorkerType:  types.StringValue(""),
+	}
+
+	if dynamic, ok := resource.(*workerpools.DynamicWorkerPool); ok {
+		model.WorkerType = types.StringValue(dynamic.WorkerType)
+	}
+
+	return model
+}
+
+func (r *DynamicWorkerPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_dynamic_worker_pool"
+}
+
+func (r *DynamicWorkerPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	attributes := workerPoolResourceAttributes()
+	attributes["worker_type"] = schema.StringAttribute{
+		MarkdownDescription: "The image of the workers leased from Octopus Cloud, such as `Ubuntu2204` or `Windows2022`",
+		Required:            true,
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a dynamic worker pool, whose workers are leased from Octopus Cloud on demand",
+		Attributes:          attributes,
+	}
+}
+
+func (r *DynamicWorkerPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *DynamicWorkerPoolResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create worker pool"))
+		return
+	}
+
+	var plan DynamicWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	workerPool := workerpools.NewDynamicWorkerPool(plan.Name.ValueString(), plan.WorkerType.ValueString())
+	expandDynamicWorkerPoolResourceModel(plan, workerPool)
+
+	tflog.Debug(ctx, "creating worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	created, err := workerpools.Add(r.client, workerPool)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created worker pool", map[string]interface{}{"worker_pool": created})
+
+	created, diags := moveWorkerPool(ctx, r.client, created, plan.SortOrder)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model := flattenDynamicWorkerPoolResourceModel(created)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DynamicWorkerPoolResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state DynamicWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerPoolID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, workerPoolID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	model := flattenDynamicWorkerPoolResourceModel(workerPool)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DynamicWorkerPoolResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update worker pool"))
+		return
+	}
+
+	var plan DynamicWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	workerPoolID := plan.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, workerPoolID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	dynamicWorkerPool, ok := workerPool.(*workerpools.DynamicWorkerPool)
+	if !ok {
+		res.Diagnostics.AddError("Unsupported worker pool type", fmt.Sprintf("worker pool %s is a %s", workerPoolID, workerPool.GetWorkerPoolType()))
+		return
+	}
+
+	expandDynamicWorkerPoolResourceModel(plan, dynamicWorkerPool)
+
+	tflog.Debug(ctx, "updating worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	updated, err := workerpools.Update(r.client, dynamicWorkerPool)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated worker pool", map[string]interface{}{"worker_pool": updated})
+
+	model := flattenDynamicWorkerPoolResourceModel(updated)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DynamicWorkerPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete worker pool"))
+		return
+	}
+
+	var state DynamicWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerPoolID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	err := workerpools.DeleteByID(r.client, spaceID, workerPoolID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted worker pool", map[string]interface{}{"id": workerPoolID})
+}
+
+func (r *DynamicWorkerPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing worker pool", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Worker pool not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if workerPool.GetWorkerPoolType() != workerpools.WorkerPoolTypeDynamic {
+		res.Diagnostics.AddError("Unsupported worker pool type", fmt.Sprintf("worker pool %s is a %s", id, workerPool.GetWorkerPoolType()))
+		return
+	}
+
+	model := flattenDynamicWorkerPoolResourceModel(workerPool)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
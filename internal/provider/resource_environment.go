@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/extensions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*EnvironmentResource)(nil)
+	_ resource.ResourceWithConfigure   = (*EnvironmentResource)(nil)
+	_ resource.ResourceWithImportState = (*EnvironmentResource)(nil)
+)
+
+var jiraEnvironmentTypes = []string{"unmapped", "development", "testing", "staging", "production"}
+
+func NewEnvironmentResource() resource.Resource {
+	return &EnvironmentResource{}
+}
+
+// EnvironmentResource defines the resource implementation.
+type EnvironmentResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// EnvironmentResourceModel describes the resource data model. Extension
+// settings left out of the configuration are not managed, and are kept as they
+// are on the server.
+type EnvironmentResourceModel struct {
+	SpaceID                                types.String                                    `tfsdk:"space_id"`
+	ID                                     types.String                                    `tfsdk:"id"`
+	Name                                   types.String                                    `tfsdk:"name"`
+	Slug                                   types.String                                    `tfsdk:"slug"`
+	Description                            types.String                                    `tfsdk:"description"`
+	SortOrder                              types.Int64                                     `tfsdk:"sort_order"`
+	UseGuidedFailure                       types.Bool                                      `tfsdk:"use_guided_failure"`
+	AllowDynamicInfrastructure             types.Bool                                      `tfsdk:"allow_dynamic_infrastructure"`
+	JiraExtensionSettings                  *EnvironmentJiraExtensionSettingsModel          `tfsdk:"jira_extension_settings"`
+	JiraServiceManagementExtensionSettings *EnvironmentChangeControlExtensionSettingsModel `tfsdk:"jira_service_management_extension_settings"`
+	ServiceNowExtensionSettings            *EnvironmentChangeControlExtensionSettingsModel `tfsdk:"servicenow_extension_settings"`
+}
+
+// expandEnvironmentResourceModel applies the model to the environment.
+// Extension settings which are not configured are kept from the environment.
+func expandEnvironmentResourceModel(model EnvironmentResourceModel, resource *environments.Environment) {
+	resource.Name = model.Name.ValueString()
+	resource.Description = model.Description.ValueString()
+	resource.UseGuidedFailure = model.UseGuidedFailure.ValueBool()
+	resource.AllowDynamicInfrastructure = model.AllowDynamicInfrastructure.ValueBool()
+	if !model.SortOrder.IsUnknown() {
+		resource.SortOrder = int(model.SortOrder.ValueInt64())
+	}
+
+	configured := map[extensions.ExtensionID]extensions.ExtensionSettings{}
+	if model.JiraExtensionSettings != nil {
+		configured[extensions.JiraExtensionID] = environments.NewJiraExtensionSettings(model.JiraExtensionSettings.EnvironmentType.ValueString())
+	}
+
+	if model.JiraServiceManagementExtensionSettings != nil {
+		configured[extensions.JiraServiceManagementExtensionID] = environments.NewJiraServiceManagementExtensionSettings(model.JiraServiceManagementExtensionSettings.IsEnabled.ValueBool())
+	}
+
+	if model.ServiceNowExtensionSettings != nil {
+		configured[extensions.ServiceNowExtensionID] = environments.NewServiceNowExtensionSettings(model.ServiceNowExtensionSettings.IsEnabled.ValueBool())
+	}
+
+	settings := []extensions.ExtensionSettings{}
+	for _, existing := range resource.ExtensionSettings {
+		if _, ok := configured[existing.ExtensionID()]; !ok {
+			settings = append(settings, existing)
+		}
+	}
+
+	for _, extensionID := range []extensions.ExtensionID{extensions.JiraExtensionID, extensions.JiraServiceManagementExtensionID, extensions.ServiceNowExtensionID} {
+		if setting, ok := configured[extensionID]; ok {
+			settings = append(settings, setting)
+		}
+	}
+
+	resource.ExtensionSettings = settings
+}
+
+// flattenEnvironmentResourceModel converts the resource to a model. Extension
+// settings are only included when they are managed by the prior model, or
+// when there is no prior model, such as on import.
+func flattenEnvironmentResourceModel(resource *environments.Environment, prior *EnvironmentResourceModel) *EnvironmentResourceModel {
+	environment := flattenEnvironmentDataSourceModel(resource)
+	model := EnvironmentResourceModel{
+		SpaceID:                                environment.SpaceID,
+		ID:                                     environment.ID,
+		Name:                                   environment.Name,
+		Slug:                                   environment.Slug,
+		Description:                            environment.Description,
+		SortOrder:                              environment.SortOrder,
+		UseGuidedFailure:                       environment.UseGuidedFailure,
+		AllowDynamicInfrastructure:             environment.AllowDynamicInfrastructure,
+		JiraExtensionSettings:                  environment.JiraExtensionSettings,
+		JiraServiceManagementExtensionSettings: environment.JiraServiceManagementExtensionSettings,
+		ServiceNowExtensionSettings:            environment.ServiceNowExtensionSettings,
+	}
+
+	if prior == nil {
+		return &model
+	}
+
+	if prior.JiraExtensionSettings == nil {
+		model.JiraExtensionSettings = nil
+	}
+
+	if prior.JiraServiceManagementExtensionSettings == nil {
+		model.JiraServiceManagementExtensionSettings = nil
+	}
+
+	if prior.ServiceNowExtensionSettings == nil {
+		model.ServiceNowExtensionSettings = nil
+	}
+
+	return &model
+}
+
+func (r *EnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (r *EnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage an environment",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the environment belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the environment",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "A human-readable, unique identifier, used to identify an environment",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the environment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"sort_order": schema.Int64Attribute{
+				MarkdownDescription: "The position of the environment relative to other environments. New environments are placed last when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"use_guided_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether deployments to the environment prompt for intervention when they fail",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_dynamic_infrastructure": schema.BoolAttribute{
+				MarkdownDescription: "Whether deployment targets can be created in the environment during a deployment",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"jira_extension_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Jira integration settings for the environment. Left unchanged when not set",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"environment_type": schema.StringAttribute{
+						MarkdownDescription: "The Jira environment type, one of `unmapped`, `development`, `testing`, `staging` or `production`",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(jiraEnvironmentTypes...)},
+					},
+				},
+			},
+			"jira_service_management_extension_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Jira Service Management integration settings for the environment. Left unchanged when not set",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"is_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether deployments to the environment are change controlled",
+						Required:            true,
+					},
+				},
+			},
+			"servicenow_extension_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "ServiceNow integration settings for the environment. Left unchanged when not set",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"is_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether deployments to the environment are change controlled",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create environment"))
+		return
+	}
+
+	var plan EnvironmentResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "environments")
+
+	environment := &environments.Environment{
+		SpaceID:  spaceID,
+		Resource: *resources.NewResource(),
+	}
+	expandEnvironmentResourceModel(plan, environment)
+
+	tflog.Debug(ctx, "creating environment", map[string]interface{}{"environment": environment})
+
+	environment, err := environments.Add(r.client, environment)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created environment", map[string]interface{}{"environment": environment})
+
+	// new environments are always placed last, so move it if asked to
+	if !plan.SortOrder.IsUnknown() && int64(environment.SortOrder) != plan.SortOrder.ValueInt64() {
+		environment.SortOrder = int(plan.SortOrder.ValueInt64())
+
+		tflog.Debug(ctx, "updating environment sort order", map[string]interface{}{"environment": environment})
+
+		environment, err = environments.Update(r.client, environment)
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update environment", err)...); res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	model := flattenEnvironmentResourceModel(environment, &plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state EnvironmentResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	environmentID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching environment", map[string]interface{}{"id": environmentID, "space_id": spaceID})
+
+	environment, err := environments.GetByID(r.client, spaceID, environmentID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched environment", map[string]interface{}{"environment": environment})
+
+	model := flattenEnvironmentResourceModel(environment, &state)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update environment"))
+		return
+	}
+
+	var plan EnvironmentResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "environments")
+	environmentID := plan.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching environment", map[string]interface{}{"id": environmentID, "space_id": spaceID})
+
+	environment, err := environments.GetByID(r.client, spaceID, environmentID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	expandEnvironmentResourceModel(plan, environment)
+
+	tflog.Debug(ctx, "updating environment", map[string]interface{}{"environment": environment})
+
+	environment, err = environments.Update(r.client, environment)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated environment", map[string]interface{}{"environment": environment})
+
+	model := flattenEnvironmentResourceModel(environment, &plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete environment"))
+		return
+	}
+
+	var state EnvironmentResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "environments")
+	environmentID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting environment", map[string]interface{}{"id": environmentID, "space_id": spaceID})
+
+	err := environments.DeleteByID(r.client, spaceID, environmentID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted environment", map[string]interface{}{"id": environmentID})
+}
+
+func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, identifier := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing environment", map[string]interface{}{"identifier": identifier, "space_id": spaceID})
+
+	// environments can be imported by ID or by name, a nil cache always fetches
+	var cache *LookupCache
+	var environment *environments.Environment
+	var err error
+	if strings.HasPrefix(identifier, "Environments-") {
+		environment, err = cache.LookupEnvironment(ctx, r.client, spaceID, identifier, "")
+	} else {
+		environment, err = cache.LookupEnvironment(ctx, r.client, spaceID, "", identifier)
+	}
+
+	if err == nil && environment == nil {
+		res.Diagnostics.AddError("Environment not found", fmt.Sprintf("no environment has the ID or name %s", identifier))
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get environment", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported environment", map[string]interface{}{"environment": environment})
+
+	model := flattenEnvironmentResourceModel(environment, nil)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,805 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = (*FeedResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*FeedResource)(nil)
+	_ resource.ResourceWithConfigure        = (*FeedResource)(nil)
+	_ resource.ResourceWithImportState      = (*FeedResource)(nil)
+)
+
+// feedTypeOCIRegistry is not known to the SDK.
+const feedTypeOCIRegistry = "OciRegistry"
+
+var packageAcquisitionLocations = []string{"Server", "ExecutionTarget", "NotAcquired"}
+
+func NewFeedResource() resource.Resource {
+	return &FeedResource{}
+}
+
+// FeedResource defines the resource implementation.
+type FeedResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// FeedResourceModel describes the resource data model. Exactly one of the
+// type-specific attributes is set.
+type FeedResourceModel struct {
+	SpaceID                           types.String         `tfsdk:"space_id"`
+	ID                                types.String         `tfsdk:"id"`
+	Name                              types.String         `tfsdk:"name"`
+	FeedType                          types.String         `tfsdk:"feed_type"`
+	PackageAcquisitionLocationOptions types.Set            `tfsdk:"package_acquisition_location_options"`
+	NuGet                             *NuGetFeedModel      `tfsdk:"nuget"`
+	Docker                            *DockerFeedModel     `tfsdk:"docker"`
+	Helm                              *RepositoryFeedModel `tfsdk:"helm"`
+	Maven                             *RetryingFeedModel   `tfsdk:"maven"`
+	GitHub                            *RetryingFeedModel   `tfsdk:"github"`
+	ECR                               *ECRFeedModel        `tfsdk:"ecr"`
+	OCI                               *RepositoryFeedModel `tfsdk:"oci"`
+}
+
+// RepositoryFeedModel describes a feed which only needs a URI and
+// credentials, such as Helm and OCI registries.
+type RepositoryFeedModel struct {
+	FeedURI  types.String `tfsdk:"feed_uri"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// RetryingFeedModel describes a feed which also retries failed downloads, such
+// as Maven and GitHub feeds.
+type RetryingFeedModel struct {
+	FeedURI                     types.String `tfsdk:"feed_uri"`
+	Username                    types.String `tfsdk:"username"`
+	Password                    types.String `tfsdk:"password"`
+	DownloadAttempts            types.Int64  `tfsdk:"download_attempts"`
+	DownloadRetryBackoffSeconds types.Int64  `tfsdk:"download_retry_backoff_seconds"`
+}
+
+type NuGetFeedModel struct {
+	FeedURI                     types.String `tfsdk:"feed_uri"`
+	Username                    types.String `tfsdk:"username"`
+	Password                    types.String `tfsdk:"password"`
+	DownloadAttempts            types.Int64  `tfsdk:"download_attempts"`
+	DownloadRetryBackoffSeconds types.Int64  `tfsdk:"download_retry_backoff_seconds"`
+	EnhancedMode                types.Bool   `tfsdk:"enhanced_mode"`
+}
+
+type DockerFeedModel struct {
+	FeedURI      types.String `tfsdk:"feed_uri"`
+	APIVersion   types.String `tfsdk:"api_version"`
+	RegistryPath types.String `tfsdk:"registry_path"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+}
+
+type ECRFeedModel struct {
+	Region             types.String                    `tfsdk:"region"`
+	AccessKey          types.String                    `tfsdk:"access_key"`
+	SecretKey          types.String                    `tfsdk:"secret_key"`
+	OIDCAuthentication *ECRFeedOIDCAuthenticationModel `tfsdk:"oidc_authentication"`
+}
+
+type ECRFeedOIDCAuthenticationModel struct {
+	RoleARN         types.String `tfsdk:"role_arn"`
+	SessionDuration types.String `tfsdk:"session_duration"`
+	SubjectKeys     types.List   `tfsdk:"subject_keys"`
+}
+
+// password returns the password of the feed, or null when there is no feed.
+func (m *RepositoryFeedModel) password() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.Password
+}
+
+// password returns the password of the feed, or null when there is no feed.
+func (m *RetryingFeedModel) password() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.Password
+}
+
+// password returns the password of the feed, or null when there is no feed.
+func (m *NuGetFeedModel) password() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.Password
+}
+
+// password returns the password of the feed, or null when there is no feed.
+func (m *DockerFeedModel) password() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.Password
+}
+
+// secretKey returns the secret key of the feed, or null when there is no feed.
+func (m *ECRFeedModel) secretKey() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	return m.SecretKey
+}
+
+// expandFeedSecret converts a secret to the value sent to Octopus, where an
+// empty value clears the secret.
+func expandFeedSecret(value types.String) *core.SensitiveValue {
+	return core.NewSensitiveValue(value.ValueString())
+}
+
+// flattenFeedSecret converts a secret to a model value. Octopus never returns
+// secrets, so the prior value is kept while one is set.
+func flattenFeedSecret(value *core.SensitiveValue, prior types.String) types.String {
+	if value == nil || !value.HasValue {
+		return types.StringNull()
+	}
+
+	return prior
+}
+
+// flattenOptionalString converts an empty string to null.
+func flattenOptionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// expandFeedResourceModel converts the model to a feed.
+func expandFeedResourceModel(ctx context.Context, model FeedResourceModel) (*custom.Feed, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	feed := custom.Feed{
+		SpaceID: model.SpaceID.ValueString(),
+		ID:      model.ID.ValueString(),
+		Name:    model.Name.ValueString(),
+	}
+
+	// left to the server's default for the feed type until known
+	if !model.PackageAcquisitionLocationOptions.IsUnknown() {
+		var nestedDiags diag.Diagnostics
+		feed.PackageAcquisitionLocationOptions, nestedDiags = expandStringSet(ctx, model.PackageAcquisitionLocationOptions)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	switch {
+	case model.NuGet != nil:
+		feed.FeedType = string(feeds.FeedTypeNuGet)
+		feed.FeedURI = model.NuGet.FeedURI.ValueString()
+		feed.Username = model.NuGet.Username.ValueString()
+		feed.Password = expandFeedSecret(model.NuGet.Password)
+		feed.DownloadAttempts = int(model.NuGet.DownloadAttempts.ValueInt64())
+		feed.DownloadRetryBackoffSeconds = int(model.NuGet.DownloadRetryBackoffSeconds.ValueInt64())
+		feed.EnhancedMode = model.NuGet.EnhancedMode.ValueBool()
+
+	case model.Docker != nil:
+		feed.FeedType = string(feeds.FeedTypeDocker)
+		feed.FeedURI = model.Docker.FeedURI.ValueString()
+		feed.APIVersion = model.Docker.APIVersion.ValueString()
+		feed.RegistryPath = model.Docker.RegistryPath.ValueString()
+		feed.Username = model.Docker.Username.ValueString()
+		feed.Password = expandFeedSecret(model.Docker.Password)
+
+	case model.Helm != nil:
+		feed.FeedType = string(feeds.FeedTypeHelm)
+		feed.FeedURI = model.Helm.FeedURI.ValueString()
+		feed.Username = model.Helm.Username.ValueString()
+		feed.Password = expandFeedSecret(model.Helm.Password)
+
+	case model.Maven != nil:
+		feed.FeedType = string(feeds.FeedTypeMaven)
+		feed.FeedURI = model.Maven.FeedURI.ValueString()
+		feed.Username = model.Maven.Username.ValueString()
+		feed.Password = expandFeedSecret(model.Maven.Password)
+		feed.DownloadAttempts = int(model.Maven.DownloadAttempts.ValueInt64())
+		feed.DownloadRetryBackoffSeconds = int(model.Maven.DownloadRetryBackoffSeconds.ValueInt64())
+
+	case model.GitHub != nil:
+		feed.FeedType = string(feeds.FeedTypeGitHub)
+		feed.FeedURI = model.GitHub.FeedURI.ValueString()
+		feed.Username = model.GitHub.Username.ValueString()
+		feed.Password = expandFeedSecret(model.GitHub.Password)
+		feed.DownloadAttempts = int(model.GitHub.DownloadAttempts.ValueInt64())
+		feed.DownloadRetryBackoffSeconds = int(model.GitHub.DownloadRetryBackoffSeconds.ValueInt64())
+
+	case model.ECR != nil:
+		feed.FeedType = string(feeds.FeedTypeAwsElasticContainerRegistry)
+		feed.Region = model.ECR.Region.ValueString()
+		if model.ECR.OIDCAuthentication != nil {
+			oidc := custom.FeedOIDCAuthentication{
+				RoleARN:         model.ECR.OIDCAuthentication.RoleARN.ValueString(),
+				SessionDuration: model.ECR.OIDCAuthentication.SessionDuration.ValueString(),
+			}
+
+			var nestedDiags diag.Diagnostics
+			oidc.SubjectKeys, nestedDiags = expandStringList(ctx, model.ECR.OIDCAuthentication.SubjectKeys)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+
+			feed.OIDCAuthentication = &oidc
+		} else {
+			feed.AccessKey = model.ECR.AccessKey.ValueString()
+			feed.SecretKey = expandFeedSecret(model.ECR.SecretKey)
+		}
+
+	case model.OCI != nil:
+		feed.FeedType = feedTypeOCIRegistry
+		feed.FeedURI = model.OCI.FeedURI.ValueString()
+		feed.Username = model.OCI.Username.ValueString()
+		feed.Password = expandFeedSecret(model.OCI.Password)
+	}
+
+	return &feed, diags
+}
+
+// flattenFeedResourceModel converts the feed to a model. Secrets are carried
+// over from the prior model, which is nil on import.
+func flattenFeedResourceModel(ctx context.Context, feed *custom.Feed, prior *FeedResourceModel) (*FeedResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior == nil {
+		prior = &FeedResourceModel{}
+	}
+
+	model := FeedResourceModel{
+		SpaceID:  types.StringValue(feed.SpaceID),
+		ID:       types.StringValue(feed.ID),
+		Name:     types.StringValue(feed.Name),
+		FeedType: types.StringValue(feed.FeedType),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.PackageAcquisitionLocationOptions, nestedDiags = flattenStringSet(ctx, feed.PackageAcquisitionLocationOptions)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	switch feed.FeedType {
+	case string(feeds.FeedTypeNuGet):
+		model.NuGet = &NuGetFeedModel{
+			FeedURI:                     types.StringValue(feed.FeedURI),
+			Username:                    flattenOptionalString(feed.Username),
+			Password:                    flattenFeedSecret(feed.Password, prior.NuGet.password()),
+			DownloadAttempts:            types.Int64Value(int64(feed.DownloadAttempts)),
+			DownloadRetryBackoffSeconds: types.Int64Value(int64(feed.DownloadRetryBackoffSeconds)),
+			EnhancedMode:                types.BoolValue(feed.EnhancedMode),
+		}
+
+	case string(feeds.FeedTypeDocker):
+		model.Docker = &DockerFeedModel{
+			FeedURI:      types.StringValue(feed.FeedURI),
+			APIVersion:   flattenOptionalString(feed.APIVersion),
+			RegistryPath: flattenOptionalString(feed.RegistryPath),
+			Username:     flattenOptionalString(feed.Username),
+			Password:     flattenFeedSecret(feed.Password, prior.Docker.password()),
+		}
+
+	case string(feeds.FeedTypeHelm):
+		model.Helm = &RepositoryFeedModel{
+			FeedURI:  types.StringValue(feed.FeedURI),
+			Username: flattenOptionalString(feed.Username),
+			Password: flattenFeedSecret(feed.Password, prior.Helm.password()),
+		}
+
+	case string(feeds.FeedTypeMaven):
+		model.Maven = &RetryingFeedModel{
+			FeedURI:                     types.StringValue(feed.FeedURI),
+			Username:                    flattenOptionalString(feed.Username),
+			Password:                    flattenFeedSecret(feed.Password, prior.Maven.password()),
+			DownloadAttempts:            types.Int64Value(int64(feed.DownloadAttempts)),
+			DownloadRetryBackoffSeconds: types.Int64Value(int64(feed.DownloadRetryBackoffSeconds)),
+		}
+
+	case string(feeds.FeedTypeGitHub):
+		model.GitHub = &RetryingFeedModel{
+			FeedURI:                     types.StringValue(feed.FeedURI),
+			Username:                    flattenOptionalString(feed.Username),
+			Password:                    flattenFeedSecret(feed.Password, prior.GitHub.password()),
+			DownloadAttempts:            types.Int64Value(int64(feed.DownloadAttempts)),
+			DownloadRetryBackoffSeconds: types.Int64Value(int64(feed.DownloadRetryBackoffSeconds)),
+		}
+
+	case string(feeds.FeedTypeAwsElasticContainerRegistry):
+		model.ECR = &ECRFeedModel{
+			Region:    types.StringValue(feed.Region),
+			AccessKey: flattenOptionalString(feed.AccessKey),
+			SecretKey: flattenFeedSecret(feed.SecretKey, prior.ECR.secretKey()),
+		}
+
+		if feed.OIDCAuthentication != nil && feed.OIDCAuthentication.RoleARN != "" {
+			oidc := ECRFeedOIDCAuthenticationModel{
+				RoleARN:         types.StringValue(feed.OIDCAuthentication.RoleARN),
+				SessionDuration: types.StringValue(feed.OIDCAuthentication.SessionDuration),
+			}
+
+			oidc.SubjectKeys, nestedDiags = flattenStringList(ctx, feed.OIDCAuthentication.SubjectKeys)
+			if diags.Append(nestedDiags...); diags.HasError() {
+				return nil, diags
+			}
+
+			model.ECR.OIDCAuthentication = &oidc
+		}
+
+	case feedTypeOCIRegistry:
+		model.OCI = &RepositoryFeedModel{
+			FeedURI:  types.StringValue(feed.FeedURI),
+			Username: flattenOptionalString(feed.Username),
+			Password: flattenFeedSecret(feed.Password, prior.OCI.password()),
+		}
+
+	default:
+		diags.AddError("Unsupported feed type", fmt.Sprintf("feed %s has the type %s, which is not managed by this resource", feed.ID, feed.FeedType))
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// requiresReplaceIfFeedTypeChanges replaces the feed when its type-specific
+// attribute is added or removed, as Octopus cannot change the type of a feed.
+func requiresReplaceIfFeedTypeChanges() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, res *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			res.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		"The feed is replaced when its type changes",
+		"The feed is replaced when its type changes",
+	)
+}
+
+func feedURIAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+	}
+}
+
+func feedUsernameAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The username used to access the feed",
+		Optional:            true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+func feedPasswordAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The password or token used to access the feed. Octopus never returns it, so changes made outside Terraform are not detected",
+		Optional:            true,
+		Sensitive:           true,
+	}
+}
+
+func feedDownloadAttemptsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "The number of times a package download is attempted",
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(5),
+		Validators:          []validator.Int64{int64validator.AtLeast(1)},
+	}
+}
+
+func feedDownloadRetryBackoffSecondsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "The number of seconds to wait before retrying a failed package download",
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(10),
+		Validators:          []validator.Int64{int64validator.AtLeast(0)},
+	}
+}
+
+func (r *FeedResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_feed"
+}
+
+func (r *FeedResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a package feed. Exactly one of the type-specific attributes must be set, and the feed is replaced when it changes",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the feed belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the feed",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the feed",
+				Required:            true,
+			},
+			"feed_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the feed, such as `NuGet` or `Docker`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"package_acquisition_location_options": schema.SetAttribute{
+				MarkdownDescription: "Where packages from the feed can be acquired, any of `Server`, `ExecutionTarget` or `NotAcquired`. Defaults to the options Octopus allows for the feed type",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(packageAcquisitionLocations...))},
+			},
+			"nuget": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a NuGet feed",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri":                       feedURIAttribute("The URL of the NuGet feed, such as `https://api.nuget.org/v3/index.json`"),
+					"username":                       feedUsernameAttribute(),
+					"password":                       feedPasswordAttribute(),
+					"download_attempts":              feedDownloadAttemptsAttribute(),
+					"download_retry_backoff_seconds": feedDownloadRetryBackoffSecondsAttribute(),
+					"enhanced_mode": schema.BoolAttribute{
+						MarkdownDescription: "Whether Octopus uses the feed's more efficient queries, which not all NuGet servers support",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"docker": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a Docker container registry",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri": feedURIAttribute("The URL of the registry, such as `https://index.docker.io`"),
+					"api_version": schema.StringAttribute{
+						MarkdownDescription: "The Docker registry API version, which Octopus detects when not set",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf("v1", "v2")},
+					},
+					"registry_path": schema.StringAttribute{
+						MarkdownDescription: "The host and path used to pull images when it differs from `feed_uri`",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"username": feedUsernameAttribute(),
+					"password": feedPasswordAttribute(),
+				},
+			},
+			"helm": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a Helm chart repository",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri": feedURIAttribute("The URL of the chart repository"),
+					"username": feedUsernameAttribute(),
+					"password": feedPasswordAttribute(),
+				},
+			},
+			"maven": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a Maven repository",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri":                       feedURIAttribute("The URL of the repository, such as `https://repo.maven.apache.org/maven2/`"),
+					"username":                       feedUsernameAttribute(),
+					"password":                       feedPasswordAttribute(),
+					"download_attempts":              feedDownloadAttemptsAttribute(),
+					"download_retry_backoff_seconds": feedDownloadRetryBackoffSecondsAttribute(),
+				},
+			},
+			"github": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a GitHub repository feed, which sources packages from releases and tags",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri": schema.StringAttribute{
+						MarkdownDescription: "The URL of the GitHub API",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("https://api.github.com"),
+					},
+					"username":                       feedUsernameAttribute(),
+					"password":                       feedPasswordAttribute(),
+					"download_attempts":              feedDownloadAttemptsAttribute(),
+					"download_retry_backoff_seconds": feedDownloadRetryBackoffSecondsAttribute(),
+				},
+			},
+			"ecr": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of an AWS Elastic Container Registry, accessed with either an access key or OIDC",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of the registry",
+						Required:            true,
+					},
+					"access_key": schema.StringAttribute{
+						MarkdownDescription: "The access key used to access the registry",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("oidc_authentication")),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+						},
+					},
+					"secret_key": schema.StringAttribute{
+						MarkdownDescription: "The secret key used to access the registry. Octopus never returns it, so changes made outside Terraform are not detected",
+						Optional:            true,
+						Sensitive:           true,
+						Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key"))},
+					},
+					"oidc_authentication": schema.SingleNestedAttribute{
+						MarkdownDescription: "Assume an AWS role with an OIDC token issued by Octopus",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"role_arn": schema.StringAttribute{
+								MarkdownDescription: "The ARN of the role to assume",
+								Required:            true,
+							},
+							"session_duration": schema.StringAttribute{
+								MarkdownDescription: "The duration of the role session, in seconds",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString("3600"),
+							},
+							"subject_keys": schema.ListAttribute{
+								MarkdownDescription: "Subject claims to include in the OIDC token, any of `space` or `feed`",
+								Optional:            true,
+								Computed:            true,
+								ElementType:         types.StringType,
+								Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+								Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.OneOf("space", "feed"))},
+							},
+						},
+					},
+				},
+			},
+			"oci": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of an OCI registry, such as one hosting Helm charts",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{requiresReplaceIfFeedTypeChanges()},
+				Attributes: map[string]schema.Attribute{
+					"feed_uri": feedURIAttribute("The URL of the registry, such as `oci://registry-1.docker.io`"),
+					"username": feedUsernameAttribute(),
+					"password": feedPasswordAttribute(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FeedResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *FeedResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("nuget"),
+			path.MatchRoot("docker"),
+			path.MatchRoot("helm"),
+			path.MatchRoot("maven"),
+			path.MatchRoot("github"),
+			path.MatchRoot("ecr"),
+			path.MatchRoot("oci"),
+		),
+	}
+}
+
+func (r *FeedResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create feed"))
+		return
+	}
+
+	var plan FeedResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	feed, diags := expandFeedResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	feed.SpaceID = resolveSpaceID(r.client, feed.SpaceID)
+
+	tflog.Debug(ctx, "creating feed", map[string]interface{}{"name": feed.Name, "feed_type": feed.FeedType, "space_id": feed.SpaceID})
+
+	feed, err := custom.NewClient(r.client, r.readOnly).CreateFeed(ctx, *feed)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create feed", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created feed", map[string]interface{}{"id": feed.ID})
+
+	model, diags := flattenFeedResourceModel(ctx, feed, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FeedResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state FeedResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	feedID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching feed", map[string]interface{}{"id": feedID, "space_id": spaceID})
+
+	feed, err := custom.NewClient(r.client, r.readOnly).GetFeed(ctx, spaceID, feedID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get feed", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched feed", map[string]interface{}{"id": feed.ID, "feed_type": feed.FeedType})
+
+	model, diags := flattenFeedResourceModel(ctx, feed, &state)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FeedResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update feed"))
+		return
+	}
+
+	var plan FeedResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	feed, diags := expandFeedResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	feed.SpaceID = resolveSpaceID(r.client, feed.SpaceID)
+
+	tflog.Debug(ctx, "updating feed", map[string]interface{}{"id": feed.ID, "space_id": feed.SpaceID})
+
+	feed, err := custom.NewClient(r.client, r.readOnly).UpdateFeed(ctx, *feed)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update feed", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated feed", map[string]interface{}{"id": feed.ID})
+
+	model, diags := flattenFeedResourceModel(ctx, feed, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FeedResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete feed"))
+		return
+	}
+
+	var state FeedResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	feedID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting feed", map[string]interface{}{"id": feedID, "space_id": spaceID})
+
+	err := custom.NewClient(r.client, r.readOnly).DeleteFeed(ctx, spaceID, feedID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete feed", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted feed", map[string]interface{}{"id": feedID})
+}
+
+func (r *FeedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing feed", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	feed, err := custom.NewClient(r.client, r.readOnly).GetFeed(ctx, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Feed not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get feed", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := flattenFeedResourceModel(ctx, feed, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
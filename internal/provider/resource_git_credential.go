@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*GitCredentialResource)(nil)
+	_ resource.ResourceWithConfigure   = (*GitCredentialResource)(nil)
+	_ resource.ResourceWithImportState = (*GitCredentialResource)(nil)
+)
+
+func NewGitCredentialResource() resource.Resource {
+	return &GitCredentialResource{}
+}
+
+// GitCredentialResource defines the resource implementation.
+type GitCredentialResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// GitCredentialResourceModel describes the resource data model.
+type GitCredentialResourceModel struct {
+	SpaceID         types.String `tfsdk:"space_id"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.String `tfsdk:"password_version"`
+}
+
+// expandGitCredentialResourceModel converts the model to a git credential. The
+// password is only sent when sendPassword is set, otherwise Octopus keeps the
+// stored password.
+func expandGitCredentialResourceModel(model GitCredentialResourceModel, sendPassword bool) *credentials.Resource {
+	password := &core.SensitiveValue{HasValue: true}
+	if sendPassword {
+		password = core.NewSensitiveValue(model.Password.ValueString())
+	}
+
+	resource := credentials.NewResource(model.Name.ValueString(), credentials.NewUsernamePassword(model.Username.ValueString(), password))
+	resource.ID = model.ID.ValueString()
+	resource.SpaceID = model.SpaceID.ValueString()
+	resource.Description = model.Description.ValueString()
+
+	return resource
+}
+
+// flattenGitCredentialResourceModel converts the resource to a model. Octopus
+// never returns the password, so it and its version are carried over from the
+// prior model.
+func flattenGitCredentialResourceModel(resource *credentials.Resource, prior GitCredentialResourceModel) *GitCredentialResourceModel {
+	model := GitCredentialResourceModel{
+		SpaceID:         types.StringValue(resource.SpaceID),
+		ID:              types.StringValue(resource.ID),
+		Name:            types.StringValue(resource.Name),
+		Description:     types.StringValue(resource.Description),
+		Username:        types.StringNull(),
+		Password:        prior.Password,
+		PasswordVersion: prior.PasswordVersion,
+	}
+
+	if details, ok := resource.Details.(*credentials.UsernamePassword); ok {
+		model.Username = types.StringValue(details.Username)
+	}
+
+	return &model
+}
+
+func (r *GitCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_git_credential"
+}
+
+func (r *GitCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a Git credential in the library, used by version controlled projects and steps sourced from Git",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the Git credential belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the Git credential",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Git credential",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Git credential",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to access Git repositories",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password or personal access token used to access Git repositories. Octopus never returns it, so it is not read back and changes made outside Terraform are not detected",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"password_version": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value which sends `password` to Octopus again whenever it changes, such as after the token is rotated outside Terraform",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *GitCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *GitCredentialResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create git credential"))
+		return
+	}
+
+	var plan GitCredentialResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	gitCredential := expandGitCredentialResourceModel(plan, true)
+	gitCredential.Resource = *resources.NewResource()
+
+	tflog.Debug(ctx, "creating git credential", map[string]interface{}{"name": gitCredential.Name, "space_id": gitCredential.SpaceID})
+
+	gitCredential, err := credentials.Add(r.client, gitCredential)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create git credential", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created git credential", map[string]interface{}{"id": gitCredential.ID})
+
+	model := flattenGitCredentialResourceModel(gitCredential, plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GitCredentialResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state GitCredentialResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	gitCredentialID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching git credential", map[string]interface{}{"id": gitCredentialID, "space_id": spaceID})
+
+	gitCredential, err := credentials.GetByID(r.client, spaceID, gitCredentialID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get git credential", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched git credential", map[string]interface{}{"id": gitCredential.ID})
+
+	model := flattenGitCredentialResourceModel(gitCredential, state)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GitCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update git credential"))
+		return
+	}
+
+	var plan, state GitCredentialResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	sendPassword := !plan.Password.Equal(state.Password) || !plan.PasswordVersion.Equal(state.PasswordVersion)
+	gitCredential := expandGitCredentialResourceModel(plan, sendPassword)
+
+	tflog.Debug(ctx, "updating git credential", map[string]interface{}{"id": gitCredential.ID, "space_id": gitCredential.SpaceID, "send_password": sendPassword})
+
+	gitCredential, err := credentials.Update(r.client, gitCredential)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update git credential", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated git credential", map[string]interface{}{"id": gitCredential.ID})
+
+	model := flattenGitCredentialResourceModel(gitCredential, plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GitCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete git credential"))
+		return
+	}
+
+	var state GitCredentialResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	gitCredentialID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting git credential", map[string]interface{}{"id": gitCredentialID, "space_id": spaceID})
+
+	err := credentials.DeleteByID(r.client, spaceID, gitCredentialID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete git credential", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted git credential", map[string]interface{}{"id": gitCredentialID})
+}
+
+func (r *GitCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing git credential", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	gitCredential, err := credentials.GetByID(r.client, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Git credential not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get git credential", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := gitCredential.Details.(*credentials.UsernamePassword); !ok {
+		res.Diagnostics.AddError("Unsupported git credential", fmt.Sprintf("git credential %s is not a username and password credential", id))
+		return
+	}
+
+	// the password is unknown, so the next apply sends the configured one
+	prior := GitCredentialResourceModel{Password: types.StringNull(), PasswordVersion: types.StringNull()}
+	model := flattenGitCredentialResourceModel(gitCredential, prior)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/libraryvariablesets"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*LibraryVariableSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*LibraryVariableSetResource)(nil)
+	_ resource.ResourceWithImportState = (*LibraryVariableSetResource)(nil)
+)
+
+// controlTypeDisplaySetting is the display settings key holding the control
+// used to enter a template value.
+const controlTypeDisplaySetting = "Octopus.ControlType"
+
+func NewLibraryVariableSetResource() resource.Resource {
+	return &LibraryVariableSetResource{}
+}
+
+// LibraryVariableSetResource defines the resource implementation.
+type LibraryVariableSetResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// LibraryVariableSetResourceModel describes the resource data model.
+type LibraryVariableSetResourceModel struct {
+	SpaceID       types.String                                       `tfsdk:"space_id"`
+	ID            types.String                                       `tfsdk:"id"`
+	Name          types.String                                       `tfsdk:"name"`
+	Description   types.String                                       `tfsdk:"description"`
+	VariableSetID types.String                                       `tfsdk:"variable_set_id"`
+	Templates     map[string]LibraryVariableSetTemplateResourceModel `tfsdk:"templates"`
+}
+
+// LibraryVariableSetTemplateResourceModel describes a variable template, which
+// defines a variable each tenant provides a value for.
+type LibraryVariableSetTemplateResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Label        types.String `tfsdk:"label"`
+	HelpText     types.String `tfsdk:"help_text"`
+	ControlType  types.String `tfsdk:"control_type"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+// expandLibraryVariableSetResourceModel converts the model to a resource.
+// Templates are sent ordered by name.
+func expandLibraryVariableSetResourceModel(model LibraryVariableSetResourceModel) *variables.LibraryVariableSet {
+	resource := variables.NewLibraryVariableSet(model.Name.ValueString())
+	resource.ID = model.ID.ValueString()
+	resource.SpaceID = model.SpaceID.ValueString()
+	resource.Description = model.Description.ValueString()
+	resource.VariableSetID = model.VariableSetID.ValueString()
+
+	names := make([]string, 0, len(model.Templates))
+	for name := range model.Templates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		template := model.Templates[name]
+		parameter := actiontemplates.ActionTemplateParameter{
+			Resource:        resources.Resource{ID: template.ID.ValueString()},
+			Name:            name,
+			Label:           template.Label.ValueString(),
+			HelpText:        template.HelpText.ValueString(),
+			DisplaySettings: map[string]string{controlTypeDisplaySetting: template.ControlType.ValueString()},
+		}
+
+		if !template.DefaultValue.IsNull() && !template.DefaultValue.IsUnknown() {
+			isSensitive := template.ControlType.ValueString() == string(resources.ControlTypeSensitive)
+			value := core.NewPropertyValue(template.DefaultValue.ValueString(), isSensitive)
+			parameter.DefaultValue = &value
+		}
+
+		resource.Templates = append(resource.Templates, parameter)
+	}
+
+	return resource
+}
+
+// flattenLibraryVariableSetResourceModel converts the resource to a model. The
+// server never returns sensitive default values, so they are carried over from
+// the prior model.
+func flattenLibraryVariableSetResourceModel(resource *variables.LibraryVariableSet, prior *LibraryVariableSetResourceModel) *LibraryVariableSetResourceModel {
+	model := LibraryVariableSetResourceModel{
+		SpaceID:       types.StringValue(resource.SpaceID),
+		ID:            types.StringValue(resource.ID),
+		Name:          types.StringValue(resource.Name),
+		Description:   types.StringValue(resource.Description),
+		VariableSetID: types.StringValue(resource.VariableSetID),
+		Templates:     map[string]LibraryVariableSetTemplateResourceModel{},
+	}
+
+	for _, parameter := range resource.Templates {
+		template := LibraryVariableSetTemplateResourceModel{
+			ID:           types.StringValue(parameter.ID),
+			Label:        types.StringValue(parameter.Label),
+			HelpText:     types.StringValue(parameter.HelpText),
+			ControlType:  types.StringValue(parameter.DisplaySettings[controlTypeDisplaySetting]),
+			DefaultValue: types.StringNull(),
+		}
+
+		if parameter.DefaultValue != nil {
+			priorValue := types.StringNull()
+			if prior != nil {
+				if priorTemplate, ok := prior.Templates[parameter.Name]; ok {
+					priorValue = priorTemplate.DefaultValue
+				}
+			}
+
+			if value, ok := flattenTenantVariableValue(*parameter.DefaultValue, priorValue); ok {
+				template.DefaultValue = value
+			}
+		}
+
+		model.Templates[parameter.Name] = template
+	}
+
+	return &model
+}
+
+func (r *LibraryVariableSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_library_variable_set"
+}
+
+func (r *LibraryVariableSetResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	templateObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the template, which tenant variable values refer to",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label shown for the template",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"help_text": schema.StringAttribute{
+				MarkdownDescription: "The help text shown for the template",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"control_type": schema.StringAttribute{
+				MarkdownDescription: "The control used to enter the value, one of `SingleLineText`, `MultiLineText`, `Checkbox`, `Select` or `Sensitive`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(resources.ControlTypeSingleLineText)),
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(resources.ControlTypeSingleLineText),
+					string(resources.ControlTypeMultiLineText),
+					string(resources.ControlTypeCheckbox),
+					string(resources.ControlTypeSelect),
+					string(resources.ControlTypeSensitive),
+				)},
+			},
+			"default_value": schema.StringAttribute{
+				MarkdownDescription: "The value used when a tenant does not provide one",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage library variable sets, which share variables and tenant variable templates between projects. Manage the variables of the set with `octopusdeploycontrib_library_variable_set_variable`",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the library variable set belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the library variable set",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the library variable set",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the library variable set",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"variable_set_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the variable set holding the variables of the library variable set",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"templates": schema.MapNestedAttribute{
+				MarkdownDescription: "Variable templates which each tenant connected to a project using the set provides a value for, keyed by variable name",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(templateObject.Type(), map[string]attr.Value{})),
+				NestedObject:        templateObject,
+			},
+		},
+	}
+}
+
+func (r *LibraryVariableSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *LibraryVariableSetResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create library variable set"))
+		return
+	}
+
+	var plan LibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	libraryVariableSet := expandLibraryVariableSetResourceModel(plan)
+	libraryVariableSet.SpaceID = resolveSpaceID(r.client, libraryVariableSet.SpaceID)
+
+	tflog.Debug(ctx, "creating library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	libraryVariableSet, err := libraryvariablesets.Add(r.client, libraryVariableSet)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create library variable set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	model := flattenLibraryVariableSetResourceModel(libraryVariableSet, &plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LibraryVariableSetResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state LibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	libraryVariableSetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching library variable set", map[string]interface{}{"id": libraryVariableSetID, "space_id": spaceID})
+
+	libraryVariableSet, err := libraryvariablesets.GetByID(r.client, spaceID, libraryVariableSetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get library variable set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	model := flattenLibraryVariableSetResourceModel(libraryVariableSet, &state)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LibraryVariableSetResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update library variable set"))
+		return
+	}
+
+	var plan LibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	libraryVariableSet := expandLibraryVariableSetResourceModel(plan)
+	libraryVariableSet.SpaceID = resolveSpaceID(r.client, libraryVariableSet.SpaceID)
+
+	tflog.Debug(ctx, "updating library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	libraryVariableSet, err := libraryvariablesets.Update(r.client, libraryVariableSet)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update library variable set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	model := flattenLibraryVariableSetResourceModel(libraryVariableSet, &plan)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LibraryVariableSetResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete library variable set"))
+		return
+	}
+
+	var state LibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	libraryVariableSetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting library variable set", map[string]interface{}{"id": libraryVariableSetID, "space_id": spaceID})
+
+	err := libraryvariablesets.DeleteByID(r.client, spaceID, libraryVariableSetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete library variable set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted library variable set", map[string]interface{}{"id": libraryVariableSetID})
+}
+
+func (r *LibraryVariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, libraryVariableSetID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing library variable set", map[string]interface{}{"id": libraryVariableSetID, "space_id": spaceID})
+
+	libraryVariableSet, err := libraryvariablesets.GetByID(r.client, spaceID, libraryVariableSetID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Library variable set not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get library variable set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported library variable set", map[string]interface{}{"library_variable_set": libraryVariableSet})
+
+	model := flattenLibraryVariableSetResourceModel(libraryVariableSet, nil)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*LibraryVariableSetVariableResource)(nil)
+	_ resource.ResourceWithConfigure   = (*LibraryVariableSetVariableResource)(nil)
+	_ resource.ResourceWithImportState = (*LibraryVariableSetVariableResource)(nil)
+)
+
+func NewLibraryVariableSetVariableResource() resource.Resource {
+	return &LibraryVariableSetVariableResource{
+		variableResource[LibraryVariableSetVariableResourceModel]{
+			owner: variableSetOwner[LibraryVariableSetVariableResourceModel]{
+				typeName:    "_library_variable_set_variable",
+				name:        "library variable set",
+				description: "a library variable set",
+				attribute:   "library_variable_set_id",
+				split:       splitLibraryVariableSetVariableResourceModel,
+				join:        joinLibraryVariableSetVariableResourceModel,
+			},
+		},
+	}
+}
+
+// LibraryVariableSetVariableResource defines the resource implementation.
+type LibraryVariableSetVariableResource struct {
+	variableResource[LibraryVariableSetVariableResourceModel]
+}
+
+// LibraryVariableSetVariableResourceModel describes the resource data model.
+type LibraryVariableSetVariableResourceModel struct {
+	SpaceID              types.String                 `tfsdk:"space_id"`
+	ID                   types.String                 `tfsdk:"id"`
+	LibraryVariableSetID types.String                 `tfsdk:"library_variable_set_id"`
+	Name                 types.String                 `tfsdk:"name"`
+	Type                 types.String                 `tfsdk:"type"`
+	Value                types.String                 `tfsdk:"value"`
+	IsSensitive          types.Bool                   `tfsdk:"is_sensitive"`
+	Description          types.String                 `tfsdk:"description"`
+	Prompt               *VariablePromptResourceModel `tfsdk:"prompt"`
+	Scope                *VariableScopeResourceModel  `tfsdk:"scope"`
+}
+
+// splitLibraryVariableSetVariableResourceModel separates the library variable
+// set ID from the model.
+func splitLibraryVariableSetVariableResourceModel(model LibraryVariableSetVariableResourceModel) (VariableResourceModel, string) {
+	return VariableResourceModel{
+		SpaceID:     model.SpaceID,
+		ID:          model.ID,
+		Name:        model.Name,
+		Type:        model.Type,
+		Value:       model.Value,
+		IsSensitive: model.IsSensitive,
+		Description: model.Description,
+		Prompt:      model.Prompt,
+		Scope:       model.Scope,
+	}, model.LibraryVariableSetID.ValueString()
+}
+
+// joinLibraryVariableSetVariableResourceModel combines the model with the
+// library variable set ID.
+func joinLibraryVariableSetVariableResourceModel(model VariableResourceModel, libraryVariableSetID string) LibraryVariableSetVariableResourceModel {
+	return LibraryVariableSetVariableResourceModel{
+		SpaceID:              model.SpaceID,
+		ID:                   model.ID,
+		LibraryVariableSetID: types.StringValue(libraryVariableSetID),
+		Name:                 model.Name,
+		Type:                 model.Type,
+		Value:                model.Value,
+		IsSensitive:          model.IsSensitive,
+		Description:          model.Description,
+		Prompt:               model.Prompt,
+		Scope:                model.Scope,
+	}
+}
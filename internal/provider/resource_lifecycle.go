@@ -0,0 +1,533 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*LifecycleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*LifecycleResource)(nil)
+	_ resource.ResourceWithImportState = (*LifecycleResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*LifecycleResource)(nil)
+)
+
+var retentionUnits = []string{lifecycles.RetentionUnitDays, lifecycles.RetentionUnitItems}
+
+func NewLifecycleResource() resource.Resource {
+	return &LifecycleResource{}
+}
+
+// LifecycleResource defines the resource implementation.
+type LifecycleResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// LifecycleResourceModel describes the resource data model.
+type LifecycleResourceModel struct {
+	SpaceID                 types.String                  `tfsdk:"space_id"`
+	ID                      types.String                  `tfsdk:"id"`
+	Name                    types.String                  `tfsdk:"name"`
+	Description             types.String                  `tfsdk:"description"`
+	ReleaseRetentionPolicy  *RetentionPolicyModel         `tfsdk:"release_retention_policy"`
+	TentacleRetentionPolicy *RetentionPolicyModel         `tfsdk:"tentacle_retention_policy"`
+	Phases                  []LifecyclePhaseResourceModel `tfsdk:"phases"`
+}
+
+// LifecyclePhaseResourceModel describes a phase of a lifecycle.
+type LifecyclePhaseResourceModel struct {
+	ID                                 types.String          `tfsdk:"id"`
+	Name                               types.String          `tfsdk:"name"`
+	AutomaticDeploymentTargets         types.Set             `tfsdk:"automatic_deployment_targets"`
+	OptionalDeploymentTargets          types.Set             `tfsdk:"optional_deployment_targets"`
+	MinimumEnvironmentsBeforePromotion types.Int64           `tfsdk:"minimum_environments_before_promotion"`
+	IsOptionalPhase                    types.Bool            `tfsdk:"is_optional_phase"`
+	ReleaseRetentionPolicy             *RetentionPolicyModel `tfsdk:"release_retention_policy"`
+	TentacleRetentionPolicy            *RetentionPolicyModel `tfsdk:"tentacle_retention_policy"`
+}
+
+// RetentionPolicyModel describes how long releases or deployed packages are
+// kept.
+type RetentionPolicyModel struct {
+	QuantityToKeep    types.Int64  `tfsdk:"quantity_to_keep"`
+	Unit              types.String `tfsdk:"unit"`
+	ShouldKeepForever types.Bool   `tfsdk:"should_keep_forever"`
+}
+
+func expandRetentionPolicyModel(model *RetentionPolicyModel) *core.RetentionPeriod {
+	if model == nil {
+		return nil
+	}
+
+	return core.NewRetentionPeriod(int32(model.QuantityToKeep.ValueInt64()), model.Unit.ValueString(), model.ShouldKeepForever.ValueBool())
+}
+
+func flattenRetentionPolicyModel(resource *core.RetentionPeriod) *RetentionPolicyModel {
+	if resource == nil {
+		return nil
+	}
+
+	return &RetentionPolicyModel{
+		QuantityToKeep:    types.Int64Value(int64(resource.QuantityToKeep)),
+		Unit:              types.StringValue(resource.Unit),
+		ShouldKeepForever: types.BoolValue(resource.ShouldKeepForever),
+	}
+}
+
+// expandLifecycleResourceModel converts the model to a resource.
+func expandLifecycleResourceModel(ctx context.Context, model LifecycleResourceModel) (*lifecycles.Lifecycle, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource := &lifecycles.Lifecycle{
+		SpaceID:                 model.SpaceID.ValueString(),
+		Resource:                *resources.NewResource(),
+		Name:                    model.Name.ValueString(),
+		Description:             model.Description.ValueString(),
+		ReleaseRetentionPolicy:  expandRetentionPolicyModel(model.ReleaseRetentionPolicy),
+		TentacleRetentionPolicy: expandRetentionPolicyModel(model.TentacleRetentionPolicy),
+		Phases:                  []*lifecycles.Phase{},
+	}
+	resource.ID = model.ID.ValueString()
+
+	for _, phase := range model.Phases {
+		var nestedDiags diag.Diagnostics
+		item := &lifecycles.Phase{
+			ID:                                 phase.ID.ValueString(),
+			Name:                               phase.Name.ValueString(),
+			MinimumEnvironmentsBeforePromotion: int32(phase.MinimumEnvironmentsBeforePromotion.ValueInt64()),
+			IsOptionalPhase:                    phase.IsOptionalPhase.ValueBool(),
+			ReleaseRetentionPolicy:             expandRetentionPolicyModel(phase.ReleaseRetentionPolicy),
+			TentacleRetentionPolicy:            expandRetentionPolicyModel(phase.TentacleRetentionPolicy),
+		}
+
+		item.AutomaticDeploymentTargets, nestedDiags = expandStringSet(ctx, phase.AutomaticDeploymentTargets)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		item.OptionalDeploymentTargets, nestedDiags = expandStringSet(ctx, phase.OptionalDeploymentTargets)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		resource.Phases = append(resource.Phases, item)
+	}
+
+	return resource, diags
+}
+
+// flattenLifecycleResourceModel converts the resource to a model. A lifecycle
+// without phases has null phases unless the prior model has phases, so that
+// both an omitted and an empty configuration are preserved.
+func flattenLifecycleResourceModel(ctx context.Context, resource *lifecycles.Lifecycle, prior *LifecycleResourceModel) (*LifecycleResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := LifecycleResourceModel{
+		SpaceID:                 types.StringValue(resource.SpaceID),
+		ID:                      types.StringValue(resource.ID),
+		Name:                    types.StringValue(resource.Name),
+		Description:             types.StringValue(resource.Description),
+		ReleaseRetentionPolicy:  flattenRetentionPolicyModel(resource.ReleaseRetentionPolicy),
+		TentacleRetentionPolicy: flattenRetentionPolicyModel(resource.TentacleRetentionPolicy),
+	}
+
+	if prior != nil && prior.Phases != nil {
+		model.Phases = []LifecyclePhaseResourceModel{}
+	}
+
+	for _, phase := range resource.Phases {
+		var nestedDiags diag.Diagnostics
+		item := LifecyclePhaseResourceModel{
+			ID:                                 types.StringValue(phase.ID),
+			Name:                               types.StringValue(phase.Name),
+			MinimumEnvironmentsBeforePromotion: types.Int64Value(int64(phase.MinimumEnvironmentsBeforePromotion)),
+			IsOptionalPhase:                    types.BoolValue(phase.IsOptionalPhase),
+			ReleaseRetentionPolicy:             flattenRetentionPolicyModel(phase.ReleaseRetentionPolicy),
+			TentacleRetentionPolicy:            flattenRetentionPolicyModel(phase.TentacleRetentionPolicy),
+		}
+
+		item.AutomaticDeploymentTargets, nestedDiags = flattenStringSet(ctx, phase.AutomaticDeploymentTargets)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		item.OptionalDeploymentTargets, nestedDiags = flattenStringSet(ctx, phase.OptionalDeploymentTargets)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+
+		model.Phases = append(model.Phases, item)
+	}
+
+	return &model, diags
+}
+
+// retentionPolicyResourceAttributes returns the attributes of a retention
+// policy.
+func retentionPolicyResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"quantity_to_keep": schema.Int64Attribute{
+			MarkdownDescription: "The number of days or items to keep, ignored when kept forever",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"unit": schema.StringAttribute{
+			MarkdownDescription: "The unit of `quantity_to_keep`, either `Days` or `Items`",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(lifecycles.RetentionUnitDays),
+			Validators:          []validator.String{stringvalidator.OneOf(retentionUnits...)},
+		},
+		"should_keep_forever": schema.BoolAttribute{
+			MarkdownDescription: "Whether everything is kept forever",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+// retentionPolicyKeepForever is the retention policy of new lifecycles.
+var retentionPolicyKeepForever = types.ObjectValueMust(
+	map[string]attr.Type{"quantity_to_keep": types.Int64Type, "unit": types.StringType, "should_keep_forever": types.BoolType},
+	map[string]attr.Value{"quantity_to_keep": types.Int64Value(0), "unit": types.StringValue(lifecycles.RetentionUnitDays), "should_keep_forever": types.BoolValue(true)},
+)
+
+func (r *LifecycleResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_lifecycle"
+}
+
+func (r *LifecycleResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a lifecycle, which controls how releases are promoted between environments",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the lifecycle belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the lifecycle",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the lifecycle",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the lifecycle",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"release_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How long releases are kept, applied to phases without their own policy. Releases are kept forever when not set",
+				Optional:            true,
+				Computed:            true,
+				Default:             objectdefault.StaticValue(retentionPolicyKeepForever),
+				Attributes:          retentionPolicyResourceAttributes(),
+			},
+			"tentacle_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How long extracted packages and files are kept on deployment targets, applied to phases without their own policy. Everything is kept forever when not set",
+				Optional:            true,
+				Computed:            true,
+				Default:             objectdefault.StaticValue(retentionPolicyKeepForever),
+				Attributes:          retentionPolicyResourceAttributes(),
+			},
+			"phases": schema.ListNestedAttribute{
+				MarkdownDescription: "The phases of the lifecycle, in promotion order. Every environment is available when there are no phases. Phases keep their ID when moved, or when renamed without being moved",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the phase",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the phase",
+							Required:            true,
+						},
+						"automatic_deployment_targets": schema.SetAttribute{
+							MarkdownDescription: "IDs of environments which are deployed to automatically when the phase is reached",
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.StringType,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+						},
+						"optional_deployment_targets": schema.SetAttribute{
+							MarkdownDescription: "IDs of environments which can be deployed to manually in the phase",
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.StringType,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+						},
+						"minimum_environments_before_promotion": schema.Int64Attribute{
+							MarkdownDescription: "The number of environments which must be deployed to before the next phase, or `0` for all of them",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+							Validators:          []validator.Int64{int64validator.AtLeast(0)},
+						},
+						"is_optional_phase": schema.BoolAttribute{
+							MarkdownDescription: "Whether the phase can be skipped",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"release_retention_policy": schema.SingleNestedAttribute{
+							MarkdownDescription: "How long releases are kept in the phase, inherited from the lifecycle when not set",
+							Optional:            true,
+							Attributes:          retentionPolicyResourceAttributes(),
+						},
+						"tentacle_retention_policy": schema.SingleNestedAttribute{
+							MarkdownDescription: "How long extracted packages and files are kept on deployment targets in the phase, inherited from the lifecycle when not set",
+							Optional:            true,
+							Attributes:          retentionPolicyResourceAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LifecycleResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fills in the IDs of phases which already exist, so reordering
+// phases only shows the moved phases in the plan.
+func (r *LifecycleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan LifecycleResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []LifecyclePhaseResourceModel
+	if !req.State.Raw.IsNull() {
+		var state LifecycleResourceModel
+		if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		existing = state.Phases
+	}
+
+	ids := matchNestedIDs(plan.Phases, existing, func(phase LifecyclePhaseResourceModel) (types.String, types.String) { return phase.ID, phase.Name })
+	for i := range plan.Phases {
+		plan.Phases[i].ID = types.StringUnknown()
+		if !ids[i].IsNull() {
+			plan.Phases[i].ID = ids[i]
+		}
+	}
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LifecycleResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create lifecycle"))
+		return
+	}
+
+	var plan LifecycleResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle, diags := expandLifecycleResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle.SpaceID = resolveSpaceID(r.client, lifecycle.SpaceID)
+
+	tflog.Debug(ctx, "creating lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	lifecycle, err := custom.NewClient(r.client, r.readOnly).CreateLifecycle(ctx, lifecycle)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create lifecycle", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	model, diags := flattenLifecycleResourceModel(ctx, lifecycle, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LifecycleResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state LifecycleResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	lifecycleID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching lifecycle", map[string]interface{}{"id": lifecycleID, "space_id": spaceID})
+
+	lifecycle, err := lifecycles.GetByID(r.client, spaceID, lifecycleID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get lifecycle", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	model, diags := flattenLifecycleResourceModel(ctx, lifecycle, &state)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LifecycleResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update lifecycle"))
+		return
+	}
+
+	var plan LifecycleResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle, diags := expandLifecycleResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle.SpaceID = resolveSpaceID(r.client, lifecycle.SpaceID)
+
+	tflog.Debug(ctx, "updating lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	lifecycle, err := custom.NewClient(r.client, r.readOnly).UpdateLifecycle(ctx, lifecycle)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update lifecycle", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	model, diags := flattenLifecycleResourceModel(ctx, lifecycle, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *LifecycleResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete lifecycle"))
+		return
+	}
+
+	var state LifecycleResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	lifecycleID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting lifecycle", map[string]interface{}{"id": lifecycleID, "space_id": spaceID})
+
+	err := lifecycles.DeleteByID(r.client, spaceID, lifecycleID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete lifecycle", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted lifecycle", map[string]interface{}{"id": lifecycleID})
+}
+
+func (r *LifecycleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, lifecycleID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing lifecycle", map[string]interface{}{"id": lifecycleID, "space_id": spaceID})
+
+	lifecycle, err := lifecycles.GetByID(r.client, spaceID, lifecycleID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Lifecycle not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get lifecycle", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported lifecycle", map[string]interface{}{"lifecycle": lifecycle})
+
+	model, diags := flattenLifecycleResourceModel(ctx, lifecycle, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLifecycleResourceRemovePhases(t *testing.T) {
+	spaceID := testAccPreCheck(t)
+	name := "octopusdeploycontrib_lifecycle.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecycleResourceConfig(spaceID, `[
+    {
+      name                         = "acceptance-test"
+      automatic_deployment_targets = [octopusdeploycontrib_environment.test.id]
+    },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "phases.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "phases.0.id"),
+				),
+			},
+			{
+				Config: testAccLifecycleResourceConfig(spaceID, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "phases.#", "0"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportIDInSpace(name, spaceID, "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"phases"},
+			},
+		},
+	})
+}
+
+func testAccLifecycleResourceConfig(spaceID, phases string) string {
+	return fmt.Sprintf(`
+resource "octopusdeploycontrib_environment" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_lifecycle" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+  phases   = %[2]s
+}
+`, spaceID, phases)
+}
@@ -0,0 +1,547 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*ProjectResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ProjectResource)(nil)
+	_ resource.ResourceWithImportState = (*ProjectResource)(nil)
+)
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// ProjectResourceModel describes the resource data model.
+type ProjectResourceModel struct {
+	SpaceID                       types.String                    `tfsdk:"space_id"`
+	ID                            types.String                    `tfsdk:"id"`
+	Name                          types.String                    `tfsdk:"name"`
+	Slug                          types.String                    `tfsdk:"slug"`
+	Description                   types.String                    `tfsdk:"description"`
+	ProjectGroupID                types.String                    `tfsdk:"project_group_id"`
+	LifecycleID                   types.String                    `tfsdk:"lifecycle_id"`
+	IsDisabled                    types.Bool                      `tfsdk:"is_disabled"`
+	TenantedDeploymentMode        types.String                    `tfsdk:"tenanted_deployment_mode"`
+	ReleaseVersioningStrategy     *ProjectVersioningStrategyModel `tfsdk:"release_versioning_strategy"`
+	ConnectivityPolicy            *ConnectivityPolicyModel        `tfsdk:"connectivity_policy"`
+	IncludedLibraryVariableSetIDs types.Set                       `tfsdk:"included_library_variable_set_ids"`
+	IgnoreServerManagedFields     types.Bool                      `tfsdk:"ignore_server_managed_fields"`
+	VariableSetID                 types.String                    `tfsdk:"variable_set_id"`
+	DeploymentProcessID           types.String                    `tfsdk:"deployment_process_id"`
+}
+
+// ProjectVersioningStrategyModel describes how release versions of a project
+// are chosen, either from a template or from the version of a package.
+type ProjectVersioningStrategyModel struct {
+	Template              types.String `tfsdk:"template"`
+	DonorPackageAction    types.String `tfsdk:"donor_package_action"`
+	DonorPackageReference types.String `tfsdk:"donor_package_reference"`
+	DonorPackageStepID    types.String `tfsdk:"donor_package_step_id"`
+}
+
+func (m *ProjectVersioningStrategyModel) equal(other *ProjectVersioningStrategyModel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+
+	return m.Template.Equal(other.Template) &&
+		m.DonorPackageAction.Equal(other.DonorPackageAction) &&
+		m.DonorPackageReference.Equal(other.DonorPackageReference)
+}
+
+func (m *ConnectivityPolicyModel) equal(other *ConnectivityPolicyModel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+
+	return m.AllowDeploymentsToNoTargets.Equal(other.AllowDeploymentsToNoTargets) &&
+		m.ExcludeUnhealthyTargets.Equal(other.ExcludeUnhealthyTargets) &&
+		m.SkipMachineBehavior.Equal(other.SkipMachineBehavior) &&
+		m.TargetRoles.Equal(other.TargetRoles)
+}
+
+// expandProjectResourceModel applies the model to the project. Settings which
+// are changed by Octopus are only applied when they have changed since the
+// prior model, if the model ignores server managed fields.
+func expandProjectResourceModel(ctx context.Context, model ProjectResourceModel, prior *ProjectResourceModel, project *projects.Project) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	project.Name = model.Name.ValueString()
+	project.Description = model.Description.ValueString()
+	project.ProjectGroupID = model.ProjectGroupID.ValueString()
+	project.LifecycleID = model.LifecycleID.ValueString()
+	project.IsDisabled = model.IsDisabled.ValueBool()
+	project.TenantedDeploymentMode = core.TenantedDeploymentMode(model.TenantedDeploymentMode.ValueString())
+
+	if !model.Slug.IsUnknown() {
+		project.Slug = model.Slug.ValueString()
+	}
+
+	ignoreServerManaged := prior != nil && model.IgnoreServerManagedFields.ValueBool()
+
+	if strategy := model.ReleaseVersioningStrategy; strategy != nil && (!ignoreServerManaged || !strategy.equal(prior.ReleaseVersioningStrategy)) {
+		project.VersioningStrategy = &projects.VersioningStrategy{Template: strategy.Template.ValueString()}
+
+		if action := strategy.DonorPackageAction.ValueString(); action != "" {
+			project.VersioningStrategy.DonorPackage = &packages.DeploymentActionPackage{
+				DeploymentAction: action,
+				PackageReference: strategy.DonorPackageReference.ValueString(),
+			}
+
+			if stepID := strategy.DonorPackageStepID.ValueString(); stepID != "" {
+				project.VersioningStrategy.DonorPackageStepID = &stepID
+			}
+		}
+	}
+
+	if policy := model.ConnectivityPolicy; policy != nil && (!ignoreServerManaged || !policy.equal(prior.ConnectivityPolicy)) {
+		project.ConnectivityPolicy = &core.ConnectivityPolicy{
+			AllowDeploymentsToNoTargets: policy.AllowDeploymentsToNoTargets.ValueBool(),
+			ExcludeUnhealthyTargets:     policy.ExcludeUnhealthyTargets.ValueBool(),
+			SkipMachineBehavior:         core.SkipMachineBehavior(policy.SkipMachineBehavior.ValueString()),
+		}
+
+		var nestedDiags diag.Diagnostics
+		project.ConnectivityPolicy.TargetRoles, nestedDiags = expandStringSet(ctx, policy.TargetRoles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return diags
+		}
+	}
+
+	// library variable sets are left alone when unset, so they can be included
+	// by octopusdeploycontrib_project_library_variable_set instead
+	if !model.IncludedLibraryVariableSetIDs.IsNull() {
+		var nestedDiags diag.Diagnostics
+		project.IncludedLibraryVariableSets, nestedDiags = expandStringSet(ctx, model.IncludedLibraryVariableSetIDs)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// flattenProjectResourceModel converts the project to a model. Settings which
+// the prior model doesn't manage are left unset, and settings changed by
+// Octopus keep their prior value when the prior model ignores server managed
+// fields. A nil prior model, on import, manages every setting.
+func flattenProjectResourceModel(ctx context.Context, project *projects.Project, prior *ProjectResourceModel) (*ProjectResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ProjectResourceModel{
+		SpaceID:                       types.StringValue(project.SpaceID),
+		ID:                            types.StringValue(project.ID),
+		Name:                          types.StringValue(project.Name),
+		Slug:                          types.StringValue(project.Slug),
+		Description:                   types.StringValue(project.Description),
+		ProjectGroupID:                types.StringValue(project.ProjectGroupID),
+		LifecycleID:                   types.StringValue(project.LifecycleID),
+		IsDisabled:                    types.BoolValue(project.IsDisabled),
+		TenantedDeploymentMode:        types.StringValue(string(project.TenantedDeploymentMode)),
+		IncludedLibraryVariableSetIDs: types.SetNull(types.StringType),
+		IgnoreServerManagedFields:     types.BoolValue(false),
+		VariableSetID:                 types.StringValue(project.VariableSetID),
+		DeploymentProcessID:           types.StringValue(project.DeploymentProcessID),
+	}
+
+	if prior != nil {
+		model.IgnoreServerManagedFields = prior.IgnoreServerManagedFields
+	}
+
+	ignoreServerManaged := model.IgnoreServerManagedFields.ValueBool()
+
+	if prior != nil && ignoreServerManaged {
+		if prior.ReleaseVersioningStrategy != nil {
+			strategy := *prior.ReleaseVersioningStrategy
+			if strategy.DonorPackageStepID.IsUnknown() {
+				strategy.DonorPackageStepID = types.StringNull()
+				if project.VersioningStrategy != nil && project.VersioningStrategy.DonorPackageStepID != nil {
+					strategy.DonorPackageStepID = types.StringValue(*project.VersioningStrategy.DonorPackageStepID)
+				}
+			}
+
+			model.ReleaseVersioningStrategy = &strategy
+		}
+	} else if strategy := project.VersioningStrategy; strategy != nil && (prior == nil || prior.ReleaseVersioningStrategy != nil) {
+		model.ReleaseVersioningStrategy = &ProjectVersioningStrategyModel{
+			Template:              types.StringNull(),
+			DonorPackageAction:    types.StringNull(),
+			DonorPackageReference: types.StringValue(""),
+			DonorPackageStepID:    types.StringNull(),
+		}
+
+		if strategy.Template != "" {
+			model.ReleaseVersioningStrategy.Template = types.StringValue(strategy.Template)
+		}
+
+		if strategy.DonorPackage != nil {
+			model.ReleaseVersioningStrategy.DonorPackageAction = types.StringValue(strategy.DonorPackage.DeploymentAction)
+			model.ReleaseVersioningStrategy.DonorPackageReference = types.StringValue(strategy.DonorPackage.PackageReference)
+		}
+
+		if strategy.DonorPackageStepID != nil {
+			model.ReleaseVersioningStrategy.DonorPackageStepID = types.StringValue(*strategy.DonorPackageStepID)
+		}
+	}
+
+	var nestedDiags diag.Diagnostics
+	if prior != nil && ignoreServerManaged {
+		model.ConnectivityPolicy = prior.ConnectivityPolicy
+	} else if policy := project.ConnectivityPolicy; policy != nil {
+		model.ConnectivityPolicy = &ConnectivityPolicyModel{
+			AllowDeploymentsToNoTargets: types.BoolValue(policy.AllowDeploymentsToNoTargets),
+			ExcludeUnhealthyTargets:     types.BoolValue(policy.ExcludeUnhealthyTargets),
+			SkipMachineBehavior:         types.StringValue(string(policy.SkipMachineBehavior)),
+		}
+
+		model.ConnectivityPolicy.TargetRoles, nestedDiags = flattenStringSet(ctx, policy.TargetRoles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if prior != nil && !prior.IncludedLibraryVariableSetIDs.IsNull() {
+		model.IncludedLibraryVariableSetIDs, nestedDiags = flattenStringSet(ctx, project.IncludedLibraryVariableSets)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return &model, diags
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage the settings of a project. The deployment process, variables and other settings of the project are left alone",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the project belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the project",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project. This name must be unique",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "A human-readable, unique identifier, used in URLs. Octopus generates one from the name when it isn't set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the project",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"project_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project group that the project belongs to",
+				Required:            true,
+			},
+			"lifecycle_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the lifecycle used by the project",
+				Required:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is disabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tenanted_deployment_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the project deploys to tenants, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(core.TenantedDeploymentModeUntenanted)),
+				Validators:          []validator.String{stringvalidator.OneOf(multiTenancyModes...)},
+			},
+			"release_versioning_strategy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How release versions are chosen, either from `template` or from the version of a package. Left as it is when unset",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"template": schema.StringAttribute{
+						MarkdownDescription: "The template of release versions, such as `#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}`",
+						Optional:            true,
+						Validators: []validator.String{stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("donor_package_action"),
+						)},
+					},
+					"donor_package_action": schema.StringAttribute{
+						MarkdownDescription: "The name of the action whose package version is used as the release version",
+						Optional:            true,
+					},
+					"donor_package_reference": schema.StringAttribute{
+						MarkdownDescription: "The name of the package reference of the action, empty for the action's primary package",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"donor_package_step_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the step of the action, which changes when the step is replaced",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+				},
+			},
+			"connectivity_policy": connectivityPolicyResourceAttribute(),
+			"included_library_variable_set_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the library variable sets included in the project. Left as they are when unset, so `octopusdeploycontrib_project_library_variable_set` can be used instead",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ignore_server_managed_fields": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ignore changes Octopus or other tools make to `release_versioning_strategy` and `connectivity_policy`, such as when the deployment process is edited. They are then only written when their configuration changes",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"variable_set_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the variable set owned by the project",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"deployment_process_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the deployment process owned by the project",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create project"))
+		return
+	}
+
+	var plan ProjectResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+
+	project := projects.NewProject(plan.Name.ValueString(), plan.LifecycleID.ValueString(), plan.ProjectGroupID.ValueString())
+	project.SpaceID = spaceID
+
+	if res.Diagnostics.Append(expandProjectResourceModel(ctx, plan, nil, project)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating project", map[string]interface{}{"project": project})
+
+	project, err := projects.Add(r.client, project)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created project", map[string]interface{}{"project": project})
+
+	model, diags := flattenProjectResourceModel(ctx, project, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state ProjectResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	projectID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching project", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched project", map[string]interface{}{"project": project})
+
+	model, diags := flattenProjectResourceModel(ctx, project, &state)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update project"))
+		return
+	}
+
+	var plan, state ProjectResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+	projectID := plan.ID.ValueString()
+
+	// the project is also changed by octopusdeploycontrib_project_library_variable_set
+	defer lockResource(spaceID, "project", projectID)()
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(expandProjectResourceModel(ctx, plan, &state, project)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updating project", map[string]interface{}{"project": project})
+
+	project, err = projects.Update(r.client, project)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated project", map[string]interface{}{"project": project})
+
+	model, diags := flattenProjectResourceModel(ctx, project, &plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete project"))
+		return
+	}
+
+	var state ProjectResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+	projectID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting project", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	err := projects.DeleteByID(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted project", map[string]interface{}{"id": projectID})
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing project", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Project not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported project", map[string]interface{}{"project": project})
+
+	model, diags := flattenProjectResourceModel(ctx, project, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
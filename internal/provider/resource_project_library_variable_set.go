@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*ProjectLibraryVariableSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ProjectLibraryVariableSetResource)(nil)
+	_ resource.ResourceWithImportState = (*ProjectLibraryVariableSetResource)(nil)
+)
+
+func NewProjectLibraryVariableSetResource() resource.Resource {
+	return &ProjectLibraryVariableSetResource{}
+}
+
+// ProjectLibraryVariableSetResource defines the resource implementation.
+type ProjectLibraryVariableSetResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// ProjectLibraryVariableSetResourceModel describes the resource data model.
+type ProjectLibraryVariableSetResourceModel struct {
+	SpaceID              types.String `tfsdk:"space_id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	LibraryVariableSetID types.String `tfsdk:"library_variable_set_id"`
+}
+
+func (r *ProjectLibraryVariableSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_project_library_variable_set"
+}
+
+func (r *ProjectLibraryVariableSetResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to include a library variable set in a project, leaving the rest of the project untouched",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the project belongs to",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project to include the library variable set in",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"library_variable_set_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the library variable set to include",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *ProjectLibraryVariableSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+func (r *ProjectLibraryVariableSetResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("include library variable set"))
+		return
+	}
+
+	var plan ProjectLibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+	projectID := plan.ProjectID.ValueString()
+	libraryVariableSetID := plan.LibraryVariableSetID.ValueString()
+
+	defer lockResource(spaceID, "project", projectID)()
+
+	tflog.Debug(ctx, "fetching project", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Contains(project.IncludedLibraryVariableSets, libraryVariableSetID) {
+		tflog.Debug(ctx, "including library variable set", map[string]interface{}{"project_id": projectID, "library_variable_set_id": libraryVariableSetID})
+
+		project.IncludedLibraryVariableSets = append(project.IncludedLibraryVariableSets, libraryVariableSetID)
+		_, err = projects.Update(r.client, project)
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update project", err)...); res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.SpaceID = types.StringValue(spaceID)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectLibraryVariableSetResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state ProjectLibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	projectID := state.ProjectID.ValueString()
+	libraryVariableSetID := state.LibraryVariableSetID.ValueString()
+
+	tflog.Debug(ctx, "fetching project", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Contains(project.IncludedLibraryVariableSets, libraryVariableSetID) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	state.SpaceID = types.StringValue(spaceID)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectLibraryVariableSetResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update library variable set inclusion"))
+		return
+	}
+
+	// every attribute requires replacement, so there is nothing to update
+	var plan ProjectLibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectLibraryVariableSetResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("exclude library variable set"))
+		return
+	}
+
+	var state ProjectLibraryVariableSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+	projectID := state.ProjectID.ValueString()
+	libraryVariableSetID := state.LibraryVariableSetID.ValueString()
+
+	defer lockResource(spaceID, "project", projectID)()
+
+	tflog.Debug(ctx, "fetching project", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	index := slices.Index(project.IncludedLibraryVariableSets, libraryVariableSetID)
+	if index < 0 {
+		return
+	}
+
+	tflog.Debug(ctx, "excluding library variable set", map[string]interface{}{"project_id": projectID, "library_variable_set_id": libraryVariableSetID})
+
+	project.IncludedLibraryVariableSets = slices.Delete(project.IncludedLibraryVariableSets, index, index+1)
+	_, err = projects.Update(r.client, project)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectLibraryVariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		res.Diagnostics.AddError(
+			"Error importing project library variable set",
+			"ID should be in the form [space_id/]project_id:library_variable_set_id",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "imported project library variable set", map[string]interface{}{
+		"space_id":                spaceID,
+		"project_id":              parts[0],
+		"library_variable_set_id": parts[1],
+	})
+
+	model := ProjectLibraryVariableSetResourceModel{
+		SpaceID:              types.StringValue(resolveSpaceID(r.client, spaceID)),
+		ProjectID:            types.StringValue(parts[0]),
+		LibraryVariableSetID: types.StringValue(parts[1]),
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/filters"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,7 +39,8 @@ func NewProjectTriggerResource() resource.Resource {
 
 // ProjectTriggerResource defines the resource implementation.
 type ProjectTriggerResource struct {
-	client *client.Client
+	client   *client.Client
+	readOnly bool
 }
 
 // ProjectTriggerResourceModel describes the resource data model.
@@ -224,7 +226,7 @@ func (r *ProjectTriggerResource) Schema(ctx context.Context, req resource.Schema
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"runbook_id": schema.StringAttribute{
-						MarkdownDescription: "The unique identifier of the runbook that the trigger is associated with",
+						MarkdownDescription: "The unique identifier of the runbook that the trigger is associated with, which can be looked up by name with the `octopusdeploycontrib_runbook` data source",
 						Required:            true,
 					},
 					"environment_ids": schema.ListAttribute{
@@ -273,13 +275,14 @@ func (r *ProjectTriggerResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
 }
 
 func (r *ProjectTriggerResource) ConfigValidators(context.Context) []resource.ConfigValidator {
@@ -301,6 +304,11 @@ func (r *ProjectTriggerResource) ConfigValidators(context.Context) []resource.Co
 }
 
 func (r *ProjectTriggerResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create trigger"))
+		return
+	}
+
 	var plan ProjectTriggerResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
@@ -311,9 +319,11 @@ func (r *ProjectTriggerResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	trigger.SpaceID = resolveSpaceID(r.client, trigger.SpaceID)
+
 	tflog.Debug(ctx, "creating trigger", map[string]interface{}{"trigger": fmt.Sprintf("%#v", trigger), "plan": fmt.Sprintf("%#v", plan)})
 
-	trigger, err := r.client.ProjectTriggers.Add(trigger)
+	trigger, err := custom.NewClient(r.client, r.readOnly).CreateProjectTrigger(ctx, *trigger)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create trigger", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -337,10 +347,11 @@ func (r *ProjectTriggerResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	triggerID := state.ID.ValueString()
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
 
-	tflog.Debug(ctx, "fetching trigger", map[string]interface{}{"id": triggerID})
+	tflog.Debug(ctx, "fetching trigger", map[string]interface{}{"id": triggerID, "space_id": spaceID})
 
-	trigger, err := r.client.ProjectTriggers.GetByID(triggerID)
+	trigger, err := custom.NewClient(r.client, r.readOnly).GetProjectTrigger(ctx, spaceID, triggerID)
 	if isAPIErrorNotFound(err) {
 		res.State.RemoveResource(ctx)
 		return
@@ -363,6 +374,11 @@ func (r *ProjectTriggerResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ProjectTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update trigger"))
+		return
+	}
+
 	var plan ProjectTriggerResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
@@ -373,9 +389,11 @@ func (r *ProjectTriggerResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	trigger.SpaceID = resolveSpaceID(r.client, trigger.SpaceID)
+
 	tflog.Debug(ctx, "updating trigger", map[string]interface{}{"trigger": trigger})
 
-	trigger, err := r.client.ProjectTriggers.Update(trigger)
+	trigger, err := custom.NewClient(r.client, r.readOnly).UpdateProjectTrigger(ctx, *trigger)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update trigger", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -393,6 +411,11 @@ func (r *ProjectTriggerResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ProjectTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete trigger"))
+		return
+	}
+
 	var state ProjectTriggerResourceModel
 	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
 		return
@@ -403,9 +426,11 @@ func (r *ProjectTriggerResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	trigger.SpaceID = resolveSpaceID(r.client, trigger.SpaceID)
+
 	tflog.Debug(ctx, "deleting trigger", map[string]interface{}{"trigger": trigger})
 
-	err := r.client.ProjectTriggers.Delete(trigger)
+	err := custom.NewClient(r.client, r.readOnly).DeleteProjectTrigger(ctx, trigger.SpaceID, trigger.ID)
 	if isAPIErrorNotFound(err) {
 		res.State.RemoveResource(ctx)
 		return
@@ -419,9 +444,12 @@ func (r *ProjectTriggerResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ProjectTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	tflog.Debug(ctx, "importing trigger", map[string]interface{}{"trigger_id": req.ID})
+	spaceID, triggerID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing trigger", map[string]interface{}{"trigger_id": triggerID, "space_id": spaceID})
 
-	trigger, err := r.client.ProjectTriggers.GetByID(req.ID)
+	trigger, err := custom.NewClient(r.client, r.readOnly).GetProjectTrigger(ctx, spaceID, triggerID)
 	if isAPIErrorNotFound(err) {
 		res.Diagnostics.Append(ErrAsDiagnostic("Trigger not found", err)...)
 		return
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectTriggerResourceInSpace(t *testing.T) {
+	spaceID := testAccPreCheck(t)
+	projectGroupID := testAccProjectGroupID(t, spaceID)
+	name := "octopusdeploycontrib_project_trigger.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTriggerResourceConfig(spaceID, projectGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "space_id", spaceID),
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrPair(name, "project_id", "octopusdeploycontrib_project.test", "id"),
+					resource.TestCheckResourceAttrPair(name, "run_runbook_action.runbook_id", "octopusdeploycontrib_runbook.test", "id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDInSpace(name, spaceID, "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectTriggerResourceConfig(spaceID, projectGroupID string) string {
+	return fmt.Sprintf(`
+resource "octopusdeploycontrib_environment" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_lifecycle" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_project" "test" {
+  space_id         = %[1]q
+  name             = "acceptance-test"
+  project_group_id = %[2]q
+  lifecycle_id     = octopusdeploycontrib_lifecycle.test.id
+}
+
+resource "octopusdeploycontrib_runbook" "test" {
+  space_id   = %[1]q
+  project_id = octopusdeploycontrib_project.test.id
+  name       = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_project_trigger" "test" {
+  space_id   = %[1]q
+  project_id = octopusdeploycontrib_project.test.id
+  name       = "acceptance-test"
+
+  cron_expression_schedule = {
+    cron_expression = "1 1 * * 0"
+    timezone        = "UTC"
+  }
+
+  run_runbook_action = {
+    runbook_id      = octopusdeploycontrib_runbook.test.id
+    environment_ids = [octopusdeploycontrib_environment.test.id]
+  }
+}
+`, spaceID, projectGroupID)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*ProjectVariableResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ProjectVariableResource)(nil)
+	_ resource.ResourceWithImportState = (*ProjectVariableResource)(nil)
+)
+
+func NewProjectVariableResource() resource.Resource {
+	return &ProjectVariableResource{
+		variableResource[ProjectVariableResourceModel]{
+			owner: variableSetOwner[ProjectVariableResourceModel]{
+				typeName:    "_project_variable",
+				name:        "project",
+				description: "the variable set of a project",
+				attribute:   "project_id",
+				split:       splitProjectVariableResourceModel,
+				join:        joinProjectVariableResourceModel,
+			},
+		},
+	}
+}
+
+// ProjectVariableResource defines the resource implementation.
+type ProjectVariableResource struct {
+	variableResource[ProjectVariableResourceModel]
+}
+
+// ProjectVariableResourceModel describes the resource data model.
+type ProjectVariableResourceModel struct {
+	SpaceID     types.String                 `tfsdk:"space_id"`
+	ID          types.String                 `tfsdk:"id"`
+	ProjectID   types.String                 `tfsdk:"project_id"`
+	Name        types.String                 `tfsdk:"name"`
+	Type        types.String                 `tfsdk:"type"`
+	Value       types.String                 `tfsdk:"value"`
+	IsSensitive types.Bool                   `tfsdk:"is_sensitive"`
+	Description types.String                 `tfsdk:"description"`
+	Prompt      *VariablePromptResourceModel `tfsdk:"prompt"`
+	Scope       *VariableScopeResourceModel  `tfsdk:"scope"`
+}
+
+// splitProjectVariableResourceModel separates the project ID from the model.
+func splitProjectVariableResourceModel(model ProjectVariableResourceModel) (VariableResourceModel, string) {
+	return VariableResourceModel{
+		SpaceID:     model.SpaceID,
+		ID:          model.ID,
+		Name:        model.Name,
+		Type:        model.Type,
+		Value:       model.Value,
+		IsSensitive: model.IsSensitive,
+		Description: model.Description,
+		Prompt:      model.Prompt,
+		Scope:       model.Scope,
+	}, model.ProjectID.ValueString()
+}
+
+// joinProjectVariableResourceModel combines the model with the project ID.
+func joinProjectVariableResourceModel(model VariableResourceModel, projectID string) ProjectVariableResourceModel {
+	return ProjectVariableResourceModel{
+		SpaceID:     model.SpaceID,
+		ID:          model.ID,
+		ProjectID:   types.StringValue(projectID),
+		Name:        model.Name,
+		Type:        model.Type,
+		Value:       model.Value,
+		IsSensitive: model.IsSensitive,
+		Description: model.Description,
+		Prompt:      model.Prompt,
+		Scope:       model.Scope,
+	}
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*ProjectVersionControlResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ProjectVersionControlResource)(nil)
+	_ resource.ResourceWithImportState = (*ProjectVersionControlResource)(nil)
+)
+
+func NewProjectVersionControlResource() resource.Resource {
+	return &ProjectVersionControlResource{}
+}
+
+// ProjectVersionControlResource defines the resource implementation.
+type ProjectVersionControlResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// ProjectVersionControlResourceModel describes the resource data model.
+type ProjectVersionControlResourceModel struct {
+	SpaceID             types.String `tfsdk:"space_id"`
+	ID                  types.String `tfsdk:"id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	URL                 types.String `tfsdk:"url"`
+	DefaultBranch       types.String `tfsdk:"default_branch"`
+	ProtectedBranches   types.Set    `tfsdk:"protected_branches"`
+	BasePath            types.String `tfsdk:"base_path"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	GitCredentialID     types.String `tfsdk:"git_credential_id"`
+	CommitMessage       types.String `tfsdk:"commit_message"`
+	InitialCommitBranch types.String `tfsdk:"initial_commit_branch"`
+}
+
+// expandGitPersistenceSettings converts the model to git persistence settings.
+func expandGitPersistenceSettings(ctx context.Context, model ProjectVersionControlResourceModel) (projects.GitPersistenceSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	repositoryURL, err := url.Parse(model.URL.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("url"), "Invalid repository URL", err.Error())
+		return nil, diags
+	}
+
+	protectedBranches, nestedDiags := expandStringSet(ctx, model.ProtectedBranches)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	var credential credentials.GitCredential = credentials.NewAnonymous()
+	switch {
+	case !model.GitCredentialID.IsNull():
+		credential = credentials.NewReference(model.GitCredentialID.ValueString())
+	case !model.Username.IsNull():
+		credential = credentials.NewUsernamePassword(model.Username.ValueString(), core.NewSensitiveValue(model.Password.ValueString()))
+	}
+
+	settings := projects.NewGitPersistenceSettings(
+		model.BasePath.ValueString(),
+		credential,
+		model.DefaultBranch.ValueString(),
+		protectedBranches,
+		repositoryURL,
+	)
+
+	return settings, diags
+}
+
+// flattenProjectVersionControlResourceModel converts the git persistence
+// settings of the project to a model. Passwords are not returned by Octopus, so
+// the prior password is kept.
+func flattenProjectVersionControlResourceModel(ctx context.Context, project *projects.Project, prior ProjectVersionControlResourceModel) (*ProjectVersionControlResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := ProjectVersionControlResourceModel{
+		SpaceID:             types.StringValue(project.SpaceID),
+		ID:                  types.StringValue(project.ID),
+		ProjectID:           types.StringValue(project.ID),
+		Username:            types.StringNull(),
+		Password:            types.StringNull(),
+		GitCredentialID:     types.StringNull(),
+		CommitMessage:       prior.CommitMessage,
+		InitialCommitBranch: prior.InitialCommitBranch,
+	}
+
+	settings, ok := project.PersistenceSettings.(projects.GitPersistenceSettings)
+	if !ok {
+		diags.AddError("Project is not version controlled", "project "+project.ID+" is stored in the database")
+		return nil, diags
+	}
+
+	model.URL = types.StringValue("")
+	if settings.URL() != nil {
+		model.URL = types.StringValue(settings.URL().String())
+	}
+
+	model.DefaultBranch = types.StringValue(settings.DefaultBranch())
+	model.BasePath = types.StringValue(settings.BasePath())
+
+	var nestedDiags diag.Diagnostics
+	model.ProtectedBranches, nestedDiags = flattenStringSet(ctx, settings.ProtectedBranchNamePatterns())
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	switch credential := settings.Credential().(type) {
+	case *credentials.Reference:
+		model.GitCredentialID = types.StringValue(credential.ID)
+	case *credentials.UsernamePassword:
+		model.Username = types.StringValue(credential.Username)
+		model.Password = prior.Password
+	}
+
+	return &model, diags
+}
+
+func (r *ProjectVersionControlResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_project_version_control"
+}
+
+func (r *ProjectVersionControlResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to convert a project to version control (Config as Code) and manage its Git settings. Octopus cannot convert a project back, so destroying the resource only removes it from the state",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the project belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the project",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The HTTPS URL of the Git repository",
+				Required:            true,
+			},
+			"default_branch": schema.StringAttribute{
+				MarkdownDescription: "The default branch of the repository",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("main"),
+			},
+			"protected_branches": schema.SetAttribute{
+				MarkdownDescription: "Patterns of branches which cannot be committed to from Octopus, which can include the default branch",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"base_path": schema.StringAttribute{
+				MarkdownDescription: "The directory in the repository holding the project's configuration",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(".octopus"),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to access the repository. The repository is accessed anonymously when neither `username` nor `git_credential_id` is set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+					stringvalidator.ConflictsWith(path.MatchRoot("git_credential_id")),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password or personal access token used to access the repository",
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("username"))},
+			},
+			"git_credential_id": schema.StringAttribute{
+				MarkdownDescription: "ID of a Git credential in the library used to access the repository, such as one managed by `octopusdeploycontrib_git_credential`",
+				Optional:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "The message of the commit made when the project is converted",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Initial commit of deployment process"),
+			},
+			"initial_commit_branch": schema.StringAttribute{
+				MarkdownDescription: "The branch the project is committed to when converted, if the default branch is protected",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectVersionControlResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+// apply converts the project to version control, or updates its settings when
+// it already is.
+func (r *ProjectVersionControlResource) apply(ctx context.Context, plan ProjectVersionControlResourceModel) (*ProjectVersionControlResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "projects")
+	projectID := plan.ProjectID.ValueString()
+
+	settings, nestedDiags := expandGitPersistenceSettings(ctx, plan)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	defer lockResource(spaceID, "project", projectID)()
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if diags.Append(ErrAsDiagnostic("Failed to get project", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	if project.IsVersionControlled {
+		tflog.Debug(ctx, "updating project git settings", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+		project.PersistenceSettings = settings
+		project, err = projects.Update(r.client, project)
+		if diags.Append(ErrAsDiagnostic("Failed to update project", err)...); diags.HasError() {
+			return nil, diags
+		}
+	} else {
+		tflog.Debug(ctx, "converting project to version control", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+		project, err = projects.ConvertToVCS(r.client, project, plan.CommitMessage.ValueString(), plan.InitialCommitBranch.ValueString(), settings)
+		if diags.Append(ErrAsDiagnostic("Failed to convert project to version control", err)...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	tflog.Debug(ctx, "applied project git settings", map[string]interface{}{"id": projectID})
+
+	return flattenProjectVersionControlResourceModel(ctx, project, plan)
+}
+
+func (r *ProjectVersionControlResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("convert project to version control"))
+		return
+	}
+
+	var plan ProjectVersionControlResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectVersionControlResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state ProjectVersionControlResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	projectID := state.ProjectID.ValueString()
+
+	tflog.Debug(ctx, "fetching project git settings", map[string]interface{}{"id": projectID, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, projectID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched project git settings", map[string]interface{}{"id": projectID})
+
+	model, diags := flattenProjectVersionControlResourceModel(ctx, project, state)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectVersionControlResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update project git settings"))
+		return
+	}
+
+	var plan ProjectVersionControlResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the resource from the state, as Octopus cannot convert a
+// project back to the database.
+func (r *ProjectVersionControlResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("remove project version control"))
+		return
+	}
+
+	var state ProjectVersionControlResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "leaving project version controlled", map[string]interface{}{"id": state.ProjectID.ValueString()})
+}
+
+func (r *ProjectVersionControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing project git settings", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	project, err := projects.GetByID(r.client, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Project not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get project", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	prior := ProjectVersionControlResourceModel{
+		Password:            types.StringNull(),
+		CommitMessage:       types.StringValue("Initial commit of deployment process"),
+		InitialCommitBranch: types.StringNull(),
+	}
+
+	model, diags := flattenProjectVersionControlResourceModel(ctx, project, prior)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,551 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*RunbookResource)(nil)
+	_ resource.ResourceWithConfigure   = (*RunbookResource)(nil)
+	_ resource.ResourceWithImportState = (*RunbookResource)(nil)
+)
+
+var (
+	runbookEnvironmentScopes = []string{"All", "FromProjectLifecycles", "Specified"}
+	guidedFailureModes       = []string{"EnvironmentDefault", "Off", "On"}
+	multiTenancyModes        = []string{
+		string(core.TenantedDeploymentModeUntenanted),
+		string(core.TenantedDeploymentModeTenantedOrUntenanted),
+		string(core.TenantedDeploymentModeTenanted),
+	}
+	skipMachineBehaviors = []string{
+		string(core.SkipMachineBehaviorNone),
+		string(core.SkipMachineBehaviorSkipUnavailableMachines),
+	}
+)
+
+func NewRunbookResource() resource.Resource {
+	return &RunbookResource{}
+}
+
+// RunbookResource defines the resource implementation.
+type RunbookResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// RunbookResourceModel describes the resource data model.
+type RunbookResourceModel struct {
+	SpaceID                    types.String                 `tfsdk:"space_id"`
+	ID                         types.String                 `tfsdk:"id"`
+	ProjectID                  types.String                 `tfsdk:"project_id"`
+	Name                       types.String                 `tfsdk:"name"`
+	Description                types.String                 `tfsdk:"description"`
+	EnvironmentScope           types.String                 `tfsdk:"environment_scope"`
+	Environments               types.Set                    `tfsdk:"environments"`
+	MultiTenancyMode           types.String                 `tfsdk:"multi_tenancy_mode"`
+	ConnectivityPolicy         *ConnectivityPolicyModel     `tfsdk:"connectivity_policy"`
+	RunRetentionPolicy         *RunbookRetentionPolicyModel `tfsdk:"run_retention_policy"`
+	DefaultGuidedFailureMode   types.String                 `tfsdk:"default_guided_failure_mode"`
+	ForcePackageDownload       types.Bool                   `tfsdk:"force_package_download"`
+	RunbookProcessID           types.String                 `tfsdk:"runbook_process_id"`
+	PublishedRunbookSnapshotID types.String                 `tfsdk:"published_runbook_snapshot_id"`
+}
+
+// ConnectivityPolicyModel describes how a runbook or deployment treats
+// deployment targets which are unavailable.
+type ConnectivityPolicyModel struct {
+	AllowDeploymentsToNoTargets types.Bool   `tfsdk:"allow_deployments_to_no_targets"`
+	ExcludeUnhealthyTargets     types.Bool   `tfsdk:"exclude_unhealthy_targets"`
+	SkipMachineBehavior         types.String `tfsdk:"skip_machine_behavior"`
+	TargetRoles                 types.Set    `tfsdk:"target_roles"`
+}
+
+// RunbookRetentionPolicyModel describes how many runs of a runbook are kept.
+type RunbookRetentionPolicyModel struct {
+	QuantityToKeep    types.Int64 `tfsdk:"quantity_to_keep"`
+	ShouldKeepForever types.Bool  `tfsdk:"should_keep_forever"`
+}
+
+// expandRunbookResourceModel converts the model to a resource.
+func expandRunbookResourceModel(ctx context.Context, model RunbookResourceModel) (*runbooks.Runbook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource := &runbooks.Runbook{
+		SpaceID:                  model.SpaceID.ValueString(),
+		Resource:                 *resources.NewResource(),
+		ProjectID:                model.ProjectID.ValueString(),
+		Name:                     model.Name.ValueString(),
+		Description:              model.Description.ValueString(),
+		EnvironmentScope:         model.EnvironmentScope.ValueString(),
+		MultiTenancyMode:         core.TenantedDeploymentMode(model.MultiTenancyMode.ValueString()),
+		DefaultGuidedFailureMode: model.DefaultGuidedFailureMode.ValueString(),
+		ForcePackageDownload:     model.ForcePackageDownload.ValueBool(),
+		RunbookProcessID:         model.RunbookProcessID.ValueString(),
+	}
+	resource.ID = model.ID.ValueString()
+	if !model.PublishedRunbookSnapshotID.IsUnknown() {
+		resource.PublishedRunbookSnapshotID = model.PublishedRunbookSnapshotID.ValueString()
+	}
+
+	var nestedDiags diag.Diagnostics
+	resource.Environments, nestedDiags = expandStringSet(ctx, model.Environments)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	if policy := model.ConnectivityPolicy; policy != nil {
+		resource.ConnectivityPolicy = &core.ConnectivityPolicy{
+			AllowDeploymentsToNoTargets: policy.AllowDeploymentsToNoTargets.ValueBool(),
+			ExcludeUnhealthyTargets:     policy.ExcludeUnhealthyTargets.ValueBool(),
+			SkipMachineBehavior:         core.SkipMachineBehavior(policy.SkipMachineBehavior.ValueString()),
+		}
+
+		resource.ConnectivityPolicy.TargetRoles, nestedDiags = expandStringSet(ctx, policy.TargetRoles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if policy := model.RunRetentionPolicy; policy != nil {
+		resource.RunRetentionPolicy = &runbooks.RunbookRetentionPeriod{
+			QuantityToKeep:    int32(policy.QuantityToKeep.ValueInt64()),
+			ShouldKeepForever: policy.ShouldKeepForever.ValueBool(),
+		}
+	}
+
+	return resource, diags
+}
+
+// flattenRunbookResourceModel converts the resource to a model.
+func flattenRunbookResourceModel(ctx context.Context, resource *runbooks.Runbook) (*RunbookResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := RunbookResourceModel{
+		SpaceID:                    types.StringValue(resource.SpaceID),
+		ID:                         types.StringValue(resource.ID),
+		ProjectID:                  types.StringValue(resource.ProjectID),
+		Name:                       types.StringValue(resource.Name),
+		Description:                types.StringValue(resource.Description),
+		EnvironmentScope:           types.StringValue(resource.EnvironmentScope),
+		MultiTenancyMode:           types.StringValue(string(resource.MultiTenancyMode)),
+		DefaultGuidedFailureMode:   types.StringValue(resource.DefaultGuidedFailureMode),
+		ForcePackageDownload:       types.BoolValue(resource.ForcePackageDownload),
+		RunbookProcessID:           types.StringValue(resource.RunbookProcessID),
+		PublishedRunbookSnapshotID: types.StringNull(),
+	}
+
+	if resource.PublishedRunbookSnapshotID != "" {
+		model.PublishedRunbookSnapshotID = types.StringValue(resource.PublishedRunbookSnapshotID)
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.Environments, nestedDiags = flattenStringSet(ctx, resource.Environments)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	if policy := resource.ConnectivityPolicy; policy != nil {
+		model.ConnectivityPolicy = &ConnectivityPolicyModel{
+			AllowDeploymentsToNoTargets: types.BoolValue(policy.AllowDeploymentsToNoTargets),
+			ExcludeUnhealthyTargets:     types.BoolValue(policy.ExcludeUnhealthyTargets),
+			SkipMachineBehavior:         types.StringValue(string(policy.SkipMachineBehavior)),
+		}
+
+		model.ConnectivityPolicy.TargetRoles, nestedDiags = flattenStringSet(ctx, policy.TargetRoles)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if policy := resource.RunRetentionPolicy; policy != nil {
+		model.RunRetentionPolicy = &RunbookRetentionPolicyModel{
+			QuantityToKeep:    types.Int64Value(int64(policy.QuantityToKeep)),
+			ShouldKeepForever: types.BoolValue(policy.ShouldKeepForever),
+		}
+	}
+
+	return &model, diags
+}
+
+// connectivityPolicyResourceAttribute returns the schema of a connectivity
+// policy, defaulting to deploying to available targets only.
+func connectivityPolicyResourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "How deployment targets which are unavailable or unhealthy are treated",
+		Optional:            true,
+		Computed:            true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			map[string]attr.Type{
+				"allow_deployments_to_no_targets": types.BoolType,
+				"exclude_unhealthy_targets":       types.BoolType,
+				"skip_machine_behavior":           types.StringType,
+				"target_roles":                    types.SetType{ElemType: types.StringType},
+			},
+			map[string]attr.Value{
+				"allow_deployments_to_no_targets": types.BoolValue(false),
+				"exclude_unhealthy_targets":       types.BoolValue(false),
+				"skip_machine_behavior":           types.StringValue(string(core.SkipMachineBehaviorNone)),
+				"target_roles":                    types.SetValueMust(types.StringType, []attr.Value{}),
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"allow_deployments_to_no_targets": schema.BoolAttribute{
+				MarkdownDescription: "Whether to continue when there are no deployment targets",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"exclude_unhealthy_targets": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave out deployment targets which are unhealthy",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"skip_machine_behavior": schema.StringAttribute{
+				MarkdownDescription: "Either `None`, to fail when a deployment target is unavailable, or `SkipUnavailableMachines`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(core.SkipMachineBehaviorNone)),
+				Validators:          []validator.String{stringvalidator.OneOf(skipMachineBehaviors...)},
+			},
+			"target_roles": schema.SetAttribute{
+				MarkdownDescription: "Roles of the deployment targets which can be skipped when unavailable",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *RunbookResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_runbook"
+}
+
+func (r *RunbookResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a runbook of a project. The runbook's steps are managed by `octopusdeploycontrib_runbook_process`",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the runbook belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the runbook",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project that the runbook belongs to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the runbook",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the runbook",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"environment_scope": schema.StringAttribute{
+				MarkdownDescription: "Which environments the runbook can run in, one of `All`, `FromProjectLifecycles` or `Specified`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("All"),
+				Validators:          []validator.String{stringvalidator.OneOf(runbookEnvironmentScopes...)},
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "IDs of the environments the runbook can run in, when `environment_scope` is `Specified`",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"multi_tenancy_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the runbook runs for tenants, one of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(core.TenantedDeploymentModeUntenanted)),
+				Validators:          []validator.String{stringvalidator.OneOf(multiTenancyModes...)},
+			},
+			"connectivity_policy": connectivityPolicyResourceAttribute(),
+			"run_retention_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "How many runs of the runbook are kept in each environment",
+				Optional:            true,
+				Computed:            true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{"quantity_to_keep": types.Int64Type, "should_keep_forever": types.BoolType},
+					map[string]attr.Value{"quantity_to_keep": types.Int64Value(100), "should_keep_forever": types.BoolValue(false)},
+				)),
+				Attributes: map[string]schema.Attribute{
+					"quantity_to_keep": schema.Int64Attribute{
+						MarkdownDescription: "The number of runs to keep, ignored when kept forever",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(100),
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"should_keep_forever": schema.BoolAttribute{
+						MarkdownDescription: "Whether every run is kept forever",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"default_guided_failure_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether runs prompt for intervention when they fail, one of `EnvironmentDefault`, `Off` or `On`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("EnvironmentDefault"),
+				Validators:          []validator.String{stringvalidator.OneOf(guidedFailureModes...)},
+			},
+			"force_package_download": schema.BoolAttribute{
+				MarkdownDescription: "Whether packages are downloaded again even when they are already on the deployment target",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"runbook_process_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the process holding the runbook's steps",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"published_runbook_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the published snapshot of the runbook, null until a snapshot is published",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *RunbookResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *RunbookResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create runbook"))
+		return
+	}
+
+	var plan RunbookResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	runbook, diags := expandRunbookResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	runbook.SpaceID = resolveSpaceID(r.client, runbook.SpaceID)
+
+	tflog.Debug(ctx, "creating runbook", map[string]interface{}{"runbook": runbook})
+
+	runbook, err := runbooks.Add(r.client, runbook)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created runbook", map[string]interface{}{"runbook": runbook})
+
+	model, diags := flattenRunbookResourceModel(ctx, runbook)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state RunbookResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	runbookID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching runbook", map[string]interface{}{"id": runbookID, "space_id": spaceID})
+
+	runbook, err := runbooks.GetByID(r.client, spaceID, runbookID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched runbook", map[string]interface{}{"runbook": runbook})
+
+	model, diags := flattenRunbookResourceModel(ctx, runbook)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update runbook"))
+		return
+	}
+
+	var plan RunbookResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	runbook, diags := expandRunbookResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	runbook.SpaceID = resolveSpaceID(r.client, runbook.SpaceID)
+
+	// the published snapshot is changed by publishing, so keep whatever the
+	// server has rather than what was last read
+	current, err := runbooks.GetByID(r.client, runbook.SpaceID, runbook.ID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	runbook.PublishedRunbookSnapshotID = current.PublishedRunbookSnapshotID
+
+	tflog.Debug(ctx, "updating runbook", map[string]interface{}{"runbook": runbook})
+
+	runbook, err = runbooks.Update(r.client, runbook)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated runbook", map[string]interface{}{"runbook": runbook})
+
+	model, diags := flattenRunbookResourceModel(ctx, runbook)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete runbook"))
+		return
+	}
+
+	var state RunbookResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	runbookID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting runbook", map[string]interface{}{"id": runbookID, "space_id": spaceID})
+
+	err := runbooks.DeleteByID(r.client, spaceID, runbookID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted runbook", map[string]interface{}{"id": runbookID})
+}
+
+func (r *RunbookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing runbook", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	// runbooks can be imported by ID, or by project ID and name
+	var runbook *runbooks.Runbook
+	var err error
+	if projectID, name, ok := strings.Cut(id, ":"); ok {
+		runbook, err = runbooks.GetByName(r.client, spaceID, projectID, name)
+		if err == nil && runbook == nil {
+			res.Diagnostics.AddError("Runbook not found", "project "+projectID+" has no runbook named "+name)
+			return
+		}
+	} else {
+		runbook, err = runbooks.GetByID(r.client, spaceID, id)
+	}
+
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Runbook not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported runbook", map[string]interface{}{"runbook": runbook})
+
+	model, diags := flattenRunbookResourceModel(ctx, runbook)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*RunbookProcessResource)(nil)
+	_ resource.ResourceWithConfigure   = (*RunbookProcessResource)(nil)
+	_ resource.ResourceWithImportState = (*RunbookProcessResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*RunbookProcessResource)(nil)
+)
+
+func NewRunbookProcessResource() resource.Resource {
+	return &RunbookProcessResource{}
+}
+
+// RunbookProcessResource defines the resource implementation.
+type RunbookProcessResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// RunbookProcessResourceModel describes the resource data model.
+type RunbookProcessResourceModel struct {
+	SpaceID             types.String       `tfsdk:"space_id"`
+	ID                  types.String       `tfsdk:"id"`
+	RunbookID           types.String       `tfsdk:"runbook_id"`
+	ProjectID           types.String       `tfsdk:"project_id"`
+	Steps               []ProcessStepModel `tfsdk:"steps"`
+	Publish             types.Bool         `tfsdk:"publish"`
+	PublishedSnapshotID types.String       `tfsdk:"published_snapshot_id"`
+}
+
+// flattenRunbookProcessResourceModel converts the process of the runbook to a
+// model, with the properties owned by the prior steps.
+func flattenRunbookProcessResourceModel(ctx context.Context, runbook *runbooks.Runbook, process *runbooks.RunbookProcess, prior []ProcessStepModel, isImport bool) (*RunbookProcessResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := RunbookProcessResourceModel{
+		SpaceID:             types.StringValue(process.SpaceID),
+		ID:                  types.StringValue(process.ID),
+		RunbookID:           types.StringValue(runbook.ID),
+		ProjectID:           types.StringValue(runbook.ProjectID),
+		PublishedSnapshotID: types.StringNull(),
+	}
+
+	if runbook.PublishedRunbookSnapshotID != "" {
+		model.PublishedSnapshotID = types.StringValue(runbook.PublishedRunbookSnapshotID)
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.Steps, nestedDiags = flattenProcessSteps(ctx, process.Steps, prior, isImport)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// publishRunbookSnapshot publishes a snapshot of the runbook as it is now.
+// Packages use the version of the last snapshot, or the latest version in the
+// feed when they haven't been used by a snapshot.
+func publishRunbookSnapshot(ctx context.Context, c *client.Client, customClient *custom.Client, spaceID string, runbook *runbooks.Runbook) (*runbooks.RunbookSnapshot, error) {
+	template, err := customClient.GetRunbookSnapshotTemplate(ctx, spaceID, runbook.RunbookProcessID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := runbooks.NewRunbookSnapshot(template.NextNameIncrement, runbook.ProjectID, runbook.ID)
+	snapshot.SpaceID = spaceID
+
+	for _, templatePackage := range template.Packages {
+		version := templatePackage.VersionSelectedLastRelease
+		if version == "" {
+			if !templatePackage.IsResolvable {
+				return nil, fmt.Errorf("package %s of step %s cannot be resolved to a version", templatePackage.PackageID, templatePackage.StepName)
+			}
+
+			versions, err := feeds.SearchPackageVersions(c, spaceID, templatePackage.FeedID, templatePackage.PackageID, "", 1)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(versions.Items) == 0 {
+				return nil, fmt.Errorf("package %s of step %s has no versions in feed %s", templatePackage.PackageID, templatePackage.StepName, templatePackage.FeedID)
+			}
+
+			version = versions.Items[0].Version
+		}
+
+		snapshot.SelectedPackages = append(snapshot.SelectedPackages, &packages.SelectedPackage{
+			ActionName:           templatePackage.ActionName,
+			PackageReferenceName: templatePackage.PackageReferenceName,
+			StepName:             templatePackage.StepName,
+			Version:              version,
+		})
+	}
+
+	tflog.Debug(ctx, "publishing runbook snapshot", map[string]interface{}{"snapshot": snapshot})
+
+	return customClient.PublishRunbookSnapshot(ctx, *snapshot)
+}
+
+func (r *RunbookProcessResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_runbook_process"
+}
+
+func (r *RunbookProcessResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the steps of a runbook. Destroying the resource removes every step from the runbook",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the runbook belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the runbook process",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"runbook_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the runbook",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project that the runbook belongs to",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"steps": processStepsResourceAttribute(),
+			"publish": schema.BoolAttribute{
+				MarkdownDescription: "Whether to publish a snapshot of the runbook whenever the process is changed, so triggers run the changed steps",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"published_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the published snapshot of the runbook, null until a snapshot is published",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *RunbookProcessResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fills in the IDs of steps and actions which already exist, so
+// reordering steps only shows the moved steps in the plan.
+func (r *RunbookProcessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RunbookProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []ProcessStepModel
+	if !req.State.Raw.IsNull() {
+		var state RunbookProcessResourceModel
+		if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		existing = state.Steps
+	}
+
+	matchProcessStepIDs(plan.Steps, existing)
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// apply writes the planned steps to the process of the runbook, publishing a
+// snapshot when asked to.
+func (r *RunbookProcessResource) apply(ctx context.Context, plan RunbookProcessResourceModel, prior []ProcessStepModel) (*RunbookProcessResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	runbookID := plan.RunbookID.ValueString()
+
+	runbook, err := runbooks.GetByID(r.client, spaceID, runbookID)
+	if diags.Append(ErrAsDiagnostic("Failed to get runbook", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	defer lockResource(spaceID, "runbookprocess", runbook.RunbookProcessID)()
+
+	customClient := custom.NewClient(r.client, r.readOnly)
+	process, err := customClient.GetRunbookProcess(ctx, spaceID, runbook.RunbookProcessID)
+	if diags.Append(ErrAsDiagnostic("Failed to get runbook process", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	var nestedDiags diag.Diagnostics
+	process.Steps, nestedDiags = expandProcessSteps(ctx, plan.Steps, prior, process.Steps)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "updating runbook process", map[string]interface{}{"process": process})
+
+	process, err = customClient.UpdateRunbookProcess(ctx, *process)
+	if diags.Append(ErrAsDiagnostic("Failed to update runbook process", err)...); diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "updated runbook process", map[string]interface{}{"process": process})
+
+	if plan.Publish.ValueBool() {
+		snapshot, err := publishRunbookSnapshot(ctx, r.client, customClient, spaceID, runbook)
+		if diags.Append(ErrAsDiagnostic("Failed to publish runbook snapshot", err)...); diags.HasError() {
+			return nil, diags
+		}
+
+		tflog.Debug(ctx, "published runbook snapshot", map[string]interface{}{"snapshot": snapshot})
+
+		runbook.PublishedRunbookSnapshotID = snapshot.ID
+	}
+
+	model, nestedDiags := flattenRunbookProcessResourceModel(ctx, runbook, process, plan.Steps, false)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	model.Publish = plan.Publish
+	return model, diags
+}
+
+func (r *RunbookProcessResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create runbook process"))
+		return
+	}
+
+	var plan RunbookProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan, nil)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookProcessResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state RunbookProcessResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	runbookID := state.RunbookID.ValueString()
+
+	tflog.Debug(ctx, "fetching runbook process", map[string]interface{}{"runbook_id": runbookID, "space_id": spaceID})
+
+	runbook, err := runbooks.GetByID(r.client, spaceID, runbookID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	process, err := custom.NewClient(r.client, r.readOnly).GetRunbookProcess(ctx, spaceID, runbook.RunbookProcessID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched runbook process", map[string]interface{}{"process": process})
+
+	model, diags := flattenRunbookProcessResourceModel(ctx, runbook, process, state.Steps, false)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.Publish = state.Publish
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookProcessResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update runbook process"))
+		return
+	}
+
+	var plan, state RunbookProcessResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.apply(ctx, plan, state.Steps)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RunbookProcessResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete runbook process"))
+		return
+	}
+
+	var state RunbookProcessResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	processID := state.ID.ValueString()
+
+	defer lockResource(spaceID, "runbookprocess", processID)()
+
+	customClient := custom.NewClient(r.client, r.readOnly)
+	process, err := customClient.GetRunbookProcess(ctx, spaceID, processID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removing runbook process steps", map[string]interface{}{"id": processID, "space_id": spaceID})
+
+	process.Steps = []*deployments.DeploymentStep{}
+	_, err = customClient.UpdateRunbookProcess(ctx, *process)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update runbook process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removed runbook process steps", map[string]interface{}{"id": processID})
+}
+
+func (r *RunbookProcessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing runbook process", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	// the process can be imported by its own ID or by the runbook's ID
+	runbookID := strings.TrimPrefix(id, "RunbookProcess-")
+
+	runbook, err := runbooks.GetByID(r.client, spaceID, runbookID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Runbook not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	process, err := custom.NewClient(r.client, r.readOnly).GetRunbookProcess(ctx, spaceID, runbook.RunbookProcessID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get runbook process", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported runbook process", map[string]interface{}{"process": process})
+
+	model, diags := flattenRunbookProcessResourceModel(ctx, runbook, process, nil, true)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.Publish = types.BoolValue(false)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -27,7 +27,8 @@ func NewServiceAccountOIDCIdentity() resource.Resource {
 
 // ServiceAccountOIDCIdentityResource defines the resource implementation.
 type ServiceAccountOIDCIdentityResource struct {
-	client *client.Client
+	client   *client.Client
+	readOnly bool
 }
 
 // ServiceAccountOIDCIdentityResourceModel describes the resource data model.
@@ -84,22 +85,28 @@ func (r *ServiceAccountOIDCIdentityResource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
 }
 
 func (r *ServiceAccountOIDCIdentityResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create service account oidc identity"))
+		return
+	}
+
 	var plan ServiceAccountOIDCIdentityResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
 	}
 
-	client := custom.NewClient(r.client)
+	client := custom.NewClient(r.client, r.readOnly)
 	identity := custom.OIDCIdentity{
 		ServiceAccountID: plan.UserID.ValueString(),
 		Name:             plan.Name.ValueString(),
@@ -150,7 +157,7 @@ func (r *ServiceAccountOIDCIdentityResource) Read(ctx context.Context, req resou
 		"user_id":     userID,
 	})
 
-	identity, err := custom.NewClient(r.client).GetServiceAccountOIDCIdentity(ctx, userID, identityID)
+	identity, err := custom.NewClient(r.client, r.readOnly).GetServiceAccountOIDCIdentity(ctx, userID, identityID)
 	if isAPIErrorNotFound(err) {
 		res.State.RemoveResource(ctx)
 		return
@@ -177,6 +184,11 @@ func (r *ServiceAccountOIDCIdentityResource) Read(ctx context.Context, req resou
 }
 
 func (r *ServiceAccountOIDCIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update service account oidc identity"))
+		return
+	}
+
 	var plan ServiceAccountOIDCIdentityResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
@@ -192,7 +204,7 @@ func (r *ServiceAccountOIDCIdentityResource) Update(ctx context.Context, req res
 
 	tflog.Debug(ctx, "updating service account oidc identity", map[string]interface{}{"identity": identity})
 
-	_, err := custom.NewClient(r.client).UpdateServiceAccountOIDCIdentity(ctx, identity)
+	_, err := custom.NewClient(r.client, r.readOnly).UpdateServiceAccountOIDCIdentity(ctx, identity)
 	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create service account oidc identity", err)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -214,6 +226,11 @@ func (r *ServiceAccountOIDCIdentityResource) Update(ctx context.Context, req res
 }
 
 func (r *ServiceAccountOIDCIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete service account oidc identity"))
+		return
+	}
+
 	var state ServiceAccountOIDCIdentityResourceModel
 	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
 		return
@@ -227,7 +244,7 @@ func (r *ServiceAccountOIDCIdentityResource) Delete(ctx context.Context, req res
 		"user_id":     serviceAccountID,
 	})
 
-	_, err := custom.NewClient(r.client).DeleteServiceAccountOIDCIdentity(ctx, serviceAccountID, identityID)
+	_, err := custom.NewClient(r.client, r.readOnly).DeleteServiceAccountOIDCIdentity(ctx, serviceAccountID, identityID)
 	if !isAPIErrorNotFound(err) {
 		res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete service account oidc identity", err)...)
 		return
@@ -247,7 +264,7 @@ func (r *ServiceAccountOIDCIdentityResource) ImportState(ctx context.Context, re
 		return
 	}
 
-	client := custom.NewClient(r.client)
+	client := custom.NewClient(r.client, r.readOnly)
 	identityID := parts[1]
 	serviceAccountID := parts[0]
 
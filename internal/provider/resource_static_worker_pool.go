@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*StaticWorkerPoolResource)(nil)
+	_ resource.ResourceWithConfigure   = (*StaticWorkerPoolResource)(nil)
+	_ resource.ResourceWithImportState = (*StaticWorkerPoolResource)(nil)
+)
+
+func NewStaticWorkerPoolResource() resource.Resource {
+	return &StaticWorkerPoolResource{}
+}
+
+// StaticWorkerPoolResource defines the resource implementation.
+type StaticWorkerPoolResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// StaticWorkerPoolResourceModel describes the resource data model.
+type StaticWorkerPoolResourceModel struct {
+	SpaceID     types.String `tfsdk:"space_id"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	SortOrder   types.Int64  `tfsdk:"sort_order"`
+}
+
+// expandWorkerPoolSettings applies the settings shared by every type of worker
+// pool. The default flag and sort order are left as they are until known.
+func expandWorkerPoolSettings(workerPool workerpools.IWorkerPool, spaceID, id, name, description types.String, isDefault types.Bool, sortOrder types.Int64) {
+	workerPool.SetSpaceID(spaceID.ValueString())
+	workerPool.SetID(id.ValueString())
+	workerPool.SetName(name.ValueString())
+	workerPool.SetDescription(description.ValueString())
+	if !isDefault.IsUnknown() {
+		workerPool.SetIsDefault(isDefault.ValueBool())
+	}
+
+	if !sortOrder.IsUnknown() {
+		workerPool.SetSortOrder(int(sortOrder.ValueInt64()))
+	}
+}
+
+// moveWorkerPool moves a newly created worker pool to the sort order, as new
+// worker pools are always placed last.
+func moveWorkerPool(ctx context.Context, client *client.Client, workerPool workerpools.IWorkerPool, sortOrder types.Int64) (workerpools.IWorkerPool, diag.Diagnostics) {
+	if sortOrder.IsUnknown() || int64(workerPool.GetSortOrder()) == sortOrder.ValueInt64() {
+		return workerPool, nil
+	}
+
+	workerPool.SetSortOrder(int(sortOrder.ValueInt64()))
+
+	tflog.Debug(ctx, "updating worker pool sort order", map[string]interface{}{"worker_pool": workerPool})
+
+	updated, err := workerpools.Update(client, workerPool)
+	if diags := ErrAsDiagnostic("Failed to update worker pool", err); len(diags) > 0 {
+		return nil, diags
+	}
+
+	return updated, nil
+}
+
+// expandStaticWorkerPoolResourceModel applies the model to the worker pool.
+func expandStaticWorkerPoolResourceModel(model StaticWorkerPoolResourceModel, workerPool workerpools.IWorkerPool) {
+	expandWorkerPoolSettings(workerPool, model.SpaceID, model.ID, model.Name, model.Description, model.IsDefault, model.SortOrder)
+}
+
+// flattenStaticWorkerPoolResourceModel converts the resource to a model.
+func flattenStaticWorkerPoolResourceModel(resource workerpools.IWorkerPool) *StaticWorkerPoolResourceModel {
+	return &StaticWorkerPoolResourceModel{
+		SpaceID:     types.StringValue(resource.GetSpaceID()),
+		ID:          types.StringValue(resource.GetID()),
+		Name:        types.StringValue(resource.GetName()),
+		Description: types.StringValue(resource.GetDescription()),
+		IsDefault:   types.BoolValue(resource.GetIsDefault()),
+		SortOrder:   types.Int64Value(int64(resource.GetSortOrder())),
+	}
+}
+
+// workerPoolResourceAttributes returns the attributes shared by every type of
+// worker pool.
+func workerPoolResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"space_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the space that the worker pool belongs to",
+			Computed:            true,
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the worker pool",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the worker pool",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the worker pool",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"is_default": schema.BoolAttribute{
+			MarkdownDescription: "Whether steps run on the worker pool when they do not select one. Making a pool the default unsets the previous default. Left unchanged when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"sort_order": schema.Int64Attribute{
+			MarkdownDescription: "The position of the worker pool relative to other worker pools. New worker pools are placed last when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+func (r *StaticWorkerPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_static_worker_pool"
+}
+
+func (r *StaticWorkerPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a static worker pool, made up of workers registered with Octopus",
+		Attributes:          workerPoolResourceAttributes(),
+	}
+}
+
+func (r *StaticWorkerPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *StaticWorkerPoolResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create worker pool"))
+		return
+	}
+
+	var plan StaticWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	workerPool := workerpools.NewStaticWorkerPool(plan.Name.ValueString())
+	expandStaticWorkerPoolResourceModel(plan, workerPool)
+
+	tflog.Debug(ctx, "creating worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	created, err := workerpools.Add(r.client, workerPool)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created worker pool", map[string]interface{}{"worker_pool": created})
+
+	created, diags := moveWorkerPool(ctx, r.client, created, plan.SortOrder)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model := flattenStaticWorkerPoolResourceModel(created)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticWorkerPoolResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state StaticWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerPoolID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, workerPoolID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	model := flattenStaticWorkerPoolResourceModel(workerPool)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticWorkerPoolResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update worker pool"))
+		return
+	}
+
+	var plan StaticWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	workerPoolID := plan.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, workerPoolID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	expandStaticWorkerPoolResourceModel(plan, workerPool)
+
+	tflog.Debug(ctx, "updating worker pool", map[string]interface{}{"worker_pool": workerPool})
+
+	updated, err := workerpools.Update(r.client, workerPool)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated worker pool", map[string]interface{}{"worker_pool": updated})
+
+	model := flattenStaticWorkerPoolResourceModel(updated)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticWorkerPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete worker pool"))
+		return
+	}
+
+	var state StaticWorkerPoolResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerPoolID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting worker pool", map[string]interface{}{"id": workerPoolID, "space_id": spaceID})
+
+	err := workerpools.DeleteByID(r.client, spaceID, workerPoolID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted worker pool", map[string]interface{}{"id": workerPoolID})
+}
+
+func (r *StaticWorkerPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing worker pool", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	workerPool, err := workerpools.GetByID(r.client, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Worker pool not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker pool", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if workerPool.GetWorkerPoolType() != workerpools.WorkerPoolTypeStatic {
+		res.Diagnostics.AddError("Unsupported worker pool type", fmt.Sprintf("worker pool %s is a %s", id, workerPool.GetWorkerPoolType()))
+		return
+	}
+
+	model := flattenStaticWorkerPoolResourceModel(workerPool)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
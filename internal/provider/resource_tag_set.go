@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*TagSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*TagSetResource)(nil)
+	_ resource.ResourceWithImportState = (*TagSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*TagSetResource)(nil)
+)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+func NewTagSetResource() resource.Resource {
+	return &TagSetResource{}
+}
+
+// TagSetResource defines the resource implementation.
+type TagSetResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// TagSetResourceModel describes the resource data model.
+type TagSetResourceModel struct {
+	SpaceID     types.String             `tfsdk:"space_id"`
+	ID          types.String             `tfsdk:"id"`
+	Name        types.String             `tfsdk:"name"`
+	Description types.String             `tfsdk:"description"`
+	Tags        []TagSetTagResourceModel `tfsdk:"tags"`
+}
+
+// TagSetTagResourceModel describes a tag within a tag set.
+type TagSetTagResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Color         types.String `tfsdk:"color"`
+	Description   types.String `tfsdk:"description"`
+	CanonicalName types.String `tfsdk:"canonical_name"`
+}
+
+// expandTagSetResourceModel converts the model to a resource. Tags are sorted
+// in the order they are listed.
+func expandTagSetResourceModel(model TagSetResourceModel) *tagsets.TagSet {
+	resource := &tagsets.TagSet{
+		SpaceID:     model.SpaceID.ValueString(),
+		Resource:    resources.Resource{ID: model.ID.ValueString()},
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Tags:        []*tagsets.Tag{},
+	}
+
+	for i, tag := range model.Tags {
+		resource.Tags = append(resource.Tags, &tagsets.Tag{
+			ID:          tag.ID.ValueString(),
+			Name:        tag.Name.ValueString(),
+			Color:       tag.Color.ValueString(),
+			Description: tag.Description.ValueString(),
+			SortOrder:   i,
+		})
+	}
+
+	return resource
+}
+
+// flattenTagSetResourceModel converts the resource to a model.
+func flattenTagSetResourceModel(resource *tagsets.TagSet) *TagSetResourceModel {
+	model := TagSetResourceModel{
+		SpaceID:     types.StringValue(resource.SpaceID),
+		ID:          types.StringValue(resource.ID),
+		Name:        types.StringValue(resource.Name),
+		Description: types.StringValue(resource.Description),
+		Tags:        []TagSetTagResourceModel{},
+	}
+
+	for _, tag := range resource.Tags {
+		model.Tags = append(model.Tags, TagSetTagResourceModel{
+			ID:            types.StringValue(tag.ID),
+			Name:          types.StringValue(tag.Name),
+			Color:         types.StringValue(tag.Color),
+			Description:   types.StringValue(tag.Description),
+			CanonicalName: types.StringValue(fmt.Sprintf("%s/%s", resource.Name, tag.Name)),
+		})
+	}
+
+	return &model
+}
+
+func (r *TagSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tag_set"
+}
+
+func (r *TagSetResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage tag sets and their tags, which are used to group tenants",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the tag set belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the tag set",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the tag set",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the tag set",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "The tags of the tag set, in display order. Tags keep their ID when moved, or when renamed without being moved",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the tag",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the tag",
+							Required:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "The color of the tag, in the form `#RRGGBB`",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.RegexMatches(tagColorPattern, "must be in the form #RRGGBB")},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the tag",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"canonical_name": schema.StringAttribute{
+							MarkdownDescription: "The canonical name of the tag, in the form `TagSet/Tag`, used to refer to the tag from other resources",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TagSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fills in the IDs and canonical names of tags which are already
+// known, so only new tags show as unknown in the plan.
+func (r *TagSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TagSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []TagSetTagResourceModel
+	if !req.State.Raw.IsNull() {
+		var state TagSetResourceModel
+		if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		existing = state.Tags
+	}
+
+	ids := matchNestedIDs(plan.Tags, existing, func(tag TagSetTagResourceModel) (types.String, types.String) { return tag.ID, tag.Name })
+	for i, tag := range plan.Tags {
+		plan.Tags[i].ID = types.StringUnknown()
+		if !ids[i].IsNull() {
+			plan.Tags[i].ID = ids[i]
+		}
+
+		plan.Tags[i].CanonicalName = types.StringUnknown()
+		if !plan.Name.IsUnknown() && !tag.Name.IsUnknown() {
+			plan.Tags[i].CanonicalName = types.StringValue(fmt.Sprintf("%s/%s", plan.Name.ValueString(), tag.Name.ValueString()))
+		}
+	}
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TagSetResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create tag set"))
+		return
+	}
+
+	var plan TagSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tagSet := expandTagSetResourceModel(plan)
+	tagSet.SpaceID = resolveSpaceID(r.client, tagSet.SpaceID)
+
+	tflog.Debug(ctx, "creating tag set", map[string]interface{}{"tag_set": tagSet})
+
+	tagSet, err := tagsets.Add(r.client, tagSet)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create tag set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created tag set", map[string]interface{}{"tag_set": tagSet})
+
+	model := flattenTagSetResourceModel(tagSet)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TagSetResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state TagSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tagSetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching tag set", map[string]interface{}{"id": tagSetID, "space_id": spaceID})
+
+	tagSet, err := tagsets.GetByID(r.client, spaceID, tagSetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tag set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched tag set", map[string]interface{}{"tag_set": tagSet})
+
+	model := flattenTagSetResourceModel(tagSet)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TagSetResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update tag set"))
+		return
+	}
+
+	var plan TagSetResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tagSet := expandTagSetResourceModel(plan)
+	tagSet.SpaceID = resolveSpaceID(r.client, tagSet.SpaceID)
+
+	tflog.Debug(ctx, "updating tag set", map[string]interface{}{"tag_set": tagSet})
+
+	tagSet, err := tagsets.Update(r.client, tagSet)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update tag set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated tag set", map[string]interface{}{"tag_set": tagSet})
+
+	model := flattenTagSetResourceModel(tagSet)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TagSetResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete tag set"))
+		return
+	}
+
+	var state TagSetResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tagSetID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting tag set", map[string]interface{}{"id": tagSetID, "space_id": spaceID})
+
+	err := tagsets.DeleteByID(r.client, spaceID, tagSetID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete tag set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted tag set", map[string]interface{}{"id": tagSetID})
+}
+
+func (r *TagSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, tagSetID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing tag set", map[string]interface{}{"id": tagSetID, "space_id": spaceID})
+
+	tagSet, err := tagsets.GetByID(r.client, spaceID, tagSetID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Tag set not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tag set", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported tag set", map[string]interface{}{"tag_set": tagSet})
+
+	model := flattenTagSetResourceModel(tagSet)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,458 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*TenantResource)(nil)
+	_ resource.ResourceWithConfigure   = (*TenantResource)(nil)
+	_ resource.ResourceWithImportState = (*TenantResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*TenantResource)(nil)
+)
+
+func NewTenantResource() resource.Resource {
+	return &TenantResource{}
+}
+
+// TenantResource defines the resource implementation. Project connections are
+// left to the tenant connection resource, so they are never changed here.
+type TenantResource struct {
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
+}
+
+// TenantResourceModel describes the resource data model.
+type TenantResourceModel struct {
+	SpaceID           types.String `tfsdk:"space_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	TenantTags        types.Set    `tfsdk:"tenant_tags"`
+	LogoPath          types.String `tfsdk:"logo_path"`
+	LogoSHA256        types.String `tfsdk:"logo_sha256"`
+	CloneFromTenantID types.String `tfsdk:"clone_from_tenant_id"`
+}
+
+// flattenTenantResourceModel converts the resource to a model. The logo is
+// not returned by the tenant, so the logo attributes are left null.
+func flattenTenantResourceModel(ctx context.Context, resource *tenants.Tenant) (*TenantResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := TenantResourceModel{
+		SpaceID:           types.StringValue(resource.SpaceID),
+		ID:                types.StringValue(resource.ID),
+		Name:              types.StringValue(resource.Name),
+		Description:       types.StringValue(resource.Description),
+		LogoPath:          types.StringNull(),
+		LogoSHA256:        types.StringNull(),
+		CloneFromTenantID: types.StringNull(),
+	}
+
+	if resource.ClonedFromTenantID != "" {
+		model.CloneFromTenantID = types.StringValue(resource.ClonedFromTenantID)
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.TenantTags, nestedDiags = flattenStringSet(ctx, resource.TenantTags)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// hashLogo returns the hex encoded SHA-256 checksum of the logo file.
+func hashLogo(logoPath string) (string, error) {
+	content, err := os.ReadFile(logoPath)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (r *TenantResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create and manage a tenant. Project connections are not managed here, use `octopusdeploycontrib_tenant_connection` instead",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the tenant belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the tenant",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the tenant",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the tenant",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tenant_tags": schema.SetAttribute{
+				MarkdownDescription: "Canonical names of the tags applied to the tenant, in the form `TagSet/Tag`",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"logo_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a local image file to upload as the tenant's logo. The logo is uploaded again whenever the file changes. Removing the path leaves the current logo in place",
+				Optional:            true,
+			},
+			"logo_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 checksum of the uploaded logo file",
+				Computed:            true,
+			},
+			"clone_from_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "ID of a tenant to clone when creating this tenant, copying its variables and project connections. Changing this forces a new tenant to be created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *TenantResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan hashes the logo file, so changes to its content are planned as
+// changes to the tenant.
+func (r *TenantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TenantResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.LogoPath.IsUnknown():
+		plan.LogoSHA256 = types.StringUnknown()
+	case plan.LogoPath.IsNull():
+		plan.LogoSHA256 = types.StringNull()
+	default:
+		sum, err := hashLogo(plan.LogoPath.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("logo_path"), "Failed to read logo", err.Error())
+			return
+		}
+
+		plan.LogoSHA256 = types.StringValue(sum)
+	}
+
+	if res.Diagnostics.Append(res.Plan.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// uploadLogo uploads the logo file given in the plan.
+func (r *TenantResource) uploadLogo(ctx context.Context, spaceID, tenantID string, plan TenantResourceModel) error {
+	logoPath := plan.LogoPath.ValueString()
+
+	tflog.Debug(ctx, "uploading tenant logo", map[string]interface{}{"id": tenantID, "logo_path": logoPath})
+
+	file, err := os.Open(logoPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return custom.NewClient(r.client, r.readOnly).UploadTenantLogo(ctx, spaceID, tenantID, filepath.Base(logoPath), file)
+}
+
+func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create tenant"))
+		return
+	}
+
+	var plan TenantResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
+	tenantTags, diags := expandStringSet(ctx, plan.TenantTags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	var tenant *tenants.Tenant
+	var err error
+	if sourceTenantID := plan.CloneFromTenantID.ValueString(); sourceTenantID != "" {
+		tflog.Debug(ctx, "cloning tenant", map[string]interface{}{"source_tenant_id": sourceTenantID, "name": plan.Name.ValueString()})
+
+		tenant, err = custom.NewClient(r.client, r.readOnly).CloneTenant(ctx, spaceID, sourceTenantID, tenants.TenantCloneRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		})
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to clone tenant", err)...); res.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "cloned tenant, updating tags", map[string]interface{}{"tenant": tenant})
+
+		tenant.TenantTags = tenantTags
+		tenant, err = tenants.Update(r.client, tenant)
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update tenant", err)...); res.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		tenant = &tenants.Tenant{
+			SpaceID:             spaceID,
+			Name:                plan.Name.ValueString(),
+			Description:         plan.Description.ValueString(),
+			TenantTags:          tenantTags,
+			ProjectEnvironments: map[string][]string{},
+		}
+
+		tflog.Debug(ctx, "creating tenant", map[string]interface{}{"tenant": tenant})
+
+		tenant, err = tenants.Add(r.client, tenant)
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create tenant", err)...); res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "created tenant", map[string]interface{}{"tenant": tenant})
+
+	model, diags := flattenTenantResourceModel(ctx, tenant)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	// save the tenant before uploading the logo, so a failed upload does not
+	// leave an untracked tenant behind
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.LogoPath.IsNull() {
+		return
+	}
+
+	err = r.uploadLogo(ctx, tenant.SpaceID, tenant.ID, plan)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to upload tenant logo", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.LogoPath = plan.LogoPath
+	model.LogoSHA256 = plan.LogoSHA256
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state TenantResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tenantID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"id": tenantID, "space_id": spaceID})
+
+	tenant, err := tenants.GetByID(r.client, spaceID, tenantID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tenant", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched tenant", map[string]interface{}{"tenant": tenant})
+
+	model, diags := flattenTenantResourceModel(ctx, tenant)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.LogoPath = state.LogoPath
+	model.LogoSHA256 = state.LogoSHA256
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update tenant"))
+		return
+	}
+
+	var plan, state TenantResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
+	tenantID := plan.ID.ValueString()
+	tenantTags, diags := expandStringSet(ctx, plan.TenantTags)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	// the tenant is fetched and written back whole, so lock it against
+	// tenant connections changing its project environments at the same time
+	defer lockResource(spaceID, "tenant", tenantID)()
+
+	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"id": tenantID, "space_id": spaceID})
+
+	tenant, err := tenants.GetByID(r.client, spaceID, tenantID)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tenant", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tenant.Name = plan.Name.ValueString()
+	tenant.Description = plan.Description.ValueString()
+	tenant.TenantTags = tenantTags
+
+	tflog.Debug(ctx, "updating tenant", map[string]interface{}{"tenant": tenant})
+
+	tenant, err = tenants.Update(r.client, tenant)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update tenant", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated tenant", map[string]interface{}{"tenant": tenant})
+
+	model, diags := flattenTenantResourceModel(ctx, tenant)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model.LogoPath = plan.LogoPath
+	model.LogoSHA256 = plan.LogoSHA256
+
+	if !plan.LogoPath.IsNull() && !plan.LogoSHA256.Equal(state.LogoSHA256) {
+		err = r.uploadLogo(ctx, spaceID, tenantID, plan)
+		if res.Diagnostics.Append(ErrAsDiagnostic("Failed to upload tenant logo", err)...); res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete tenant"))
+		return
+	}
+
+	var state TenantResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
+	tenantID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting tenant", map[string]interface{}{"id": tenantID, "space_id": spaceID})
+
+	err := tenants.DeleteByID(r.client, spaceID, tenantID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete tenant", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted tenant", map[string]interface{}{"id": tenantID})
+}
+
+func (r *TenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, tenantID := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing tenant", map[string]interface{}{"id": tenantID, "space_id": spaceID})
+
+	tenant, err := tenants.GetByID(r.client, spaceID, tenantID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Tenant not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tenant", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "imported tenant", map[string]interface{}{"tenant": tenant})
+
+	model, diags := flattenTenantResourceModel(ctx, tenant)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
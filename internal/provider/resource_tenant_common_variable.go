@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*TenantCommonVariableResource)(nil)
+	_ resource.ResourceWithConfigure   = (*TenantCommonVariableResource)(nil)
+	_ resource.ResourceWithImportState = (*TenantCommonVariableResource)(nil)
+)
+
+func NewTenantCommonVariableResource() resource.Resource {
+	return &TenantCommonVariableResource{}
+}
+
+// TenantCommonVariableResource defines the resource implementation.
+type TenantCommonVariableResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// TenantCommonVariableResourceModel describes the resource data model.
+type TenantCommonVariableResourceModel struct {
+	SpaceID              types.String `tfsdk:"space_id"`
+	TenantID             types.String `tfsdk:"tenant_id"`
+	LibraryVariableSetID types.String `tfsdk:"library_variable_set_id"`
+	TemplateID           types.String `tfsdk:"template_id"`
+	Value                types.String `tfsdk:"value"`
+	IsSensitive          types.Bool   `tfsdk:"is_sensitive"`
+}
+
+func (r *TenantCommonVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tenant_common_variable"
+}
+
+func (r *TenantCommonVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to set the value of a single library variable set template on a tenant, leaving the other variables of the tenant untouched",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the tenant belongs to",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the tenant to set the value on",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"library_variable_set_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the library variable set which defines the template",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the template to set the value of",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the variable. Values of sensitive templates are write-only, so changes made outside of Terraform are not detected",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"is_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the template is sensitive",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *TenantCommonVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// set writes the planned value, returning whether the template is sensitive.
+func (r *TenantCommonVariableResource) set(ctx context.Context, plan TenantCommonVariableResourceModel) (bool, error) {
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	tenantID := plan.TenantID.ValueString()
+	libraryVariableSetID := plan.LibraryVariableSetID.ValueString()
+	templateID := plan.TemplateID.ValueString()
+
+	var isSensitive bool
+	_, err := updateTenantVariables(ctx, custom.NewClient(r.client, r.readOnly), spaceID, tenantID, func(tenantVariables *variables.TenantVariables) (bool, error) {
+		library, ok := tenantVariables.LibraryVariables[libraryVariableSetID]
+		if !ok {
+			return false, fmt.Errorf("library variable set %s is not included in any project connected to tenant %s", libraryVariableSetID, tenantID)
+		}
+
+		template := findTemplate(library.Templates, templateID)
+		if template == nil {
+			return false, fmt.Errorf("template %s not found in library variable set %s", templateID, libraryVariableSetID)
+		}
+
+		isSensitive = isSensitiveTemplate(template)
+		if library.Variables == nil {
+			library.Variables = map[string]core.PropertyValue{}
+		}
+
+		library.Variables[templateID] = expandTenantVariableValue(template, plan.Value.ValueString())
+		tenantVariables.LibraryVariables[libraryVariableSetID] = library
+		return true, nil
+	})
+
+	return isSensitive, err
+}
+
+func (r *TenantCommonVariableResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create tenant common variable"))
+		return
+	}
+
+	var plan TenantCommonVariableResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "setting tenant common variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	isSensitive, err := r.set(ctx, plan)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to set tenant common variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "set tenant common variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	plan.IsSensitive = types.BoolValue(isSensitive)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantCommonVariableResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state TenantCommonVariableResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tenantID := state.TenantID.ValueString()
+	libraryVariableSetID := state.LibraryVariableSetID.ValueString()
+	templateID := state.TemplateID.ValueString()
+
+	tflog.Debug(ctx, "fetching tenant variables", map[string]interface{}{"tenant_id": tenantID, "space_id": spaceID})
+
+	tenantVariables, err := custom.NewClient(r.client, r.readOnly).GetTenantVariables(ctx, spaceID, tenantID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tenant variables", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	library, ok := tenantVariables.LibraryVariables[libraryVariableSetID]
+	if !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	template := findTemplate(library.Templates, templateID)
+	propertyValue, ok := library.Variables[templateID]
+	if template == nil || !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	value, ok := flattenTenantVariableValue(propertyValue, state.Value)
+	if !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	state.SpaceID = types.StringValue(spaceID)
+	state.Value = value
+	state.IsSensitive = types.BoolValue(isSensitiveTemplate(template))
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantCommonVariableResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update tenant common variable"))
+		return
+	}
+
+	var plan TenantCommonVariableResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "setting tenant common variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	isSensitive, err := r.set(ctx, plan)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to set tenant common variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "set tenant common variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	plan.IsSensitive = types.BoolValue(isSensitive)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantCommonVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete tenant common variable"))
+		return
+	}
+
+	var state TenantCommonVariableResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tenantID := state.TenantID.ValueString()
+	libraryVariableSetID := state.LibraryVariableSetID.ValueString()
+	templateID := state.TemplateID.ValueString()
+
+	tflog.Debug(ctx, "removing tenant common variable", map[string]interface{}{"tenant_id": tenantID, "template_id": templateID})
+
+	_, err := updateTenantVariables(ctx, custom.NewClient(r.client, r.readOnly), spaceID, tenantID, func(tenantVariables *variables.TenantVariables) (bool, error) {
+		library, ok := tenantVariables.LibraryVariables[libraryVariableSetID]
+		if !ok {
+			return false, nil
+		}
+
+		if _, ok := library.Variables[templateID]; !ok {
+			return false, nil
+		}
+
+		delete(library.Variables, templateID)
+		tenantVariables.LibraryVariables[libraryVariableSetID] = library
+		return true, nil
+	})
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to remove tenant common variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removed tenant common variable", map[string]interface{}{"tenant_id": tenantID, "template_id": templateID})
+}
+
+func (r *TenantCommonVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		res.Diagnostics.AddError(
+			"Error importing tenant common variable",
+			"ID should be in the form [space_id/]tenant_id:library_variable_set_id:template_id",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "imported tenant common variable", map[string]interface{}{
+		"space_id":                spaceID,
+		"tenant_id":               parts[0],
+		"library_variable_set_id": parts[1],
+		"template_id":             parts[2],
+	})
+
+	// the value is filled in by the read which follows the import, except for
+	// sensitive values which are never returned
+	model := TenantCommonVariableResourceModel{
+		SpaceID:              types.StringValue(resolveSpaceID(r.client, spaceID)),
+		TenantID:             types.StringValue(parts[0]),
+		LibraryVariableSetID: types.StringValue(parts[1]),
+		TemplateID:           types.StringValue(parts[2]),
+		Value:                types.StringValue(""),
+		IsSensitive:          types.BoolValue(false),
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
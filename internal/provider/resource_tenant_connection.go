@@ -29,7 +29,9 @@ func NewTenantConnectionResource() resource.Resource {
 
 // TenantConnectionResource defines the resource implementation.
 type TenantConnectionResource struct {
-	client *client.Client
+	client   *client.Client
+	cache    *LookupCache
+	readOnly bool
 }
 
 // TenantConnectionResourceModel describes the resource data model.
@@ -52,7 +54,7 @@ func (r *TenantConnectionResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "ID of the space to connect to",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
 			},
 			"tenant_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the tenant to connect to",
@@ -78,22 +80,30 @@ func (r *TenantConnectionResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
 	if !ok {
 		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.cache = data.Cache
+	r.readOnly = data.ReadOnly
 }
 
 func (r *TenantConnectionResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create tenant connection"))
+		return
+	}
+
 	var plan TenantConnectionResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
 	}
 
-	spaceID := plan.SpaceID.ValueString()
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
 	tenantID := plan.TenantID.ValueString()
 	projectID := plan.ProjectID.ValueString()
 	environmentIDVals := make([]types.String, 0, len(plan.EnvironmentIDs.Elements()))
@@ -106,6 +116,8 @@ func (r *TenantConnectionResource) Create(ctx context.Context, req resource.Crea
 		environmentIDs[i] = val.ValueString()
 	}
 
+	defer lockResource(spaceID, "tenant", tenantID)()
+
 	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"id": tenantID})
 
 	tenantsQuery, err := tenants.Get(r.client, spaceID, tenants.TenantsQuery{IDs: []string{tenantID}})
@@ -130,6 +142,8 @@ func (r *TenantConnectionResource) Create(ctx context.Context, req resource.Crea
 
 	tflog.Debug(ctx, "updated tenant project environment", map[string]interface{}{"tenant": tenant})
 
+	plan.SpaceID = types.StringValue(tenant.SpaceID)
+
 	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
 		return
 	}
@@ -141,7 +155,7 @@ func (r *TenantConnectionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	spaceID := state.SpaceID.ValueString()
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
 	tenantID := state.TenantID.ValueString()
 	projectID := state.ProjectID.ValueString()
 
@@ -172,6 +186,7 @@ func (r *TenantConnectionResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	state = TenantConnectionResourceModel{
+		SpaceID:        types.StringValue(tenant.SpaceID),
 		TenantID:       types.StringValue(tenant.ID),
 		ProjectID:      types.StringValue(projectID),
 		EnvironmentIDs: environmentIDList,
@@ -183,12 +198,18 @@ func (r *TenantConnectionResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *TenantConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update tenant connection"))
+		return
+	}
+
 	var plan TenantConnectionResourceModel
 	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
 		return
 	}
 
-	spaceID := plan.SpaceID.ValueString()
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
 	tenantID := plan.TenantID.ValueString()
 	projectID := plan.ProjectID.ValueString()
 	environmentIDVals := make([]types.String, 0, len(plan.EnvironmentIDs.Elements()))
@@ -201,6 +222,8 @@ func (r *TenantConnectionResource) Update(ctx context.Context, req resource.Upda
 		environmentIDs[i] = val.ValueString()
 	}
 
+	defer lockResource(spaceID, "tenant", tenantID)()
+
 	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"id": tenantID})
 
 	tenant, err := tenants.GetByID(r.client, spaceID, tenantID)
@@ -218,21 +241,31 @@ func (r *TenantConnectionResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Debug(ctx, "updated tenant project environments", map[string]interface{}{"tenant": tenant})
 
+	plan.SpaceID = types.StringValue(tenant.SpaceID)
+
 	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *TenantConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete tenant connection"))
+		return
+	}
+
 	var state TenantConnectionResourceModel
 	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
 		return
 	}
 
-	spaceID := state.SpaceID.ValueString()
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	defer r.cache.invalidate(spaceID, "tenants")
 	tenantID := state.TenantID.ValueString()
 	projectID := state.ProjectID.ValueString()
 
+	defer lockResource(spaceID, "tenant", tenantID)()
+
 	tflog.Debug(ctx, "fetching tenant", map[string]interface{}{"id": tenantID})
 
 	tenant, err := tenants.GetByID(r.client, spaceID, tenantID)
@@ -257,11 +290,12 @@ func (r *TenantConnectionResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *TenantConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ":")
+	spaceID, id := parseImportID(req.ID)
+	parts := strings.Split(id, ":")
 	if len(parts) != 2 && len(parts) != 3 {
 		res.Diagnostics.AddError(
 			"Error importing tenant connection",
-			"ID should be in the form tenant_id:project_id[:environment_id_1[+environment_id_2[+environment_id_n]]]",
+			"ID should be in the form [space_id/]tenant_id:project_id[:environment_id_1[+environment_id_2[+environment_id_n]]]",
 		)
 		return
 	}
@@ -282,12 +316,14 @@ func (r *TenantConnectionResource) ImportState(ctx context.Context, req resource
 	}
 
 	tflog.Debug(ctx, "imported tenant connection", map[string]interface{}{
+		"space_id":        spaceID,
 		"tenant_id":       tenantID,
 		"project_id":      projectID,
 		"environment_ids": environmentIDs,
 	})
 
 	model := TenantConnectionResourceModel{
+		SpaceID:        types.StringValue(resolveSpaceID(r.client, spaceID)),
 		TenantID:       types.StringValue(tenantID),
 		ProjectID:      types.StringValue(projectID),
 		EnvironmentIDs: environmentIDList,
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantConnectionResourceInSpace(t *testing.T) {
+	spaceID := testAccPreCheck(t)
+	projectGroupID := testAccProjectGroupID(t, spaceID)
+	name := "octopusdeploycontrib_tenant_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantConnectionResourceConfig(spaceID, projectGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "space_id", spaceID),
+					resource.TestCheckResourceAttrPair(name, "tenant_id", "octopusdeploycontrib_tenant.test", "id"),
+					resource.TestCheckResourceAttrPair(name, "project_id", "octopusdeploycontrib_project.test", "id"),
+					resource.TestCheckResourceAttrPair(name, "environment_ids.0", "octopusdeploycontrib_environment.test", "id"),
+				),
+			},
+			{
+				ResourceName:                         name,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccImportIDInSpace(name, spaceID, "tenant_id", "project_id", "environment_ids.0"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tenant_id",
+			},
+		},
+	})
+}
+
+func testAccTenantConnectionResourceConfig(spaceID, projectGroupID string) string {
+	return fmt.Sprintf(`
+resource "octopusdeploycontrib_environment" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_lifecycle" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_project" "test" {
+  space_id                 = %[1]q
+  name                     = "acceptance-test"
+  project_group_id         = %[2]q
+  lifecycle_id             = octopusdeploycontrib_lifecycle.test.id
+  tenanted_deployment_mode = "TenantedOrUntenanted"
+}
+
+resource "octopusdeploycontrib_tenant" "test" {
+  space_id = %[1]q
+  name     = "acceptance-test"
+}
+
+resource "octopusdeploycontrib_tenant_connection" "test" {
+  space_id        = %[1]q
+  tenant_id       = octopusdeploycontrib_tenant.test.id
+  project_id      = octopusdeploycontrib_project.test.id
+  environment_ids = [octopusdeploycontrib_environment.test.id]
+}
+`, spaceID, projectGroupID)
+}
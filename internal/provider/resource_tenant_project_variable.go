@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = (*TenantProjectVariableResource)(nil)
+	_ resource.ResourceWithConfigure   = (*TenantProjectVariableResource)(nil)
+	_ resource.ResourceWithImportState = (*TenantProjectVariableResource)(nil)
+)
+
+func NewTenantProjectVariableResource() resource.Resource {
+	return &TenantProjectVariableResource{}
+}
+
+// TenantProjectVariableResource defines the resource implementation.
+type TenantProjectVariableResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// TenantProjectVariableResourceModel describes the resource data model.
+type TenantProjectVariableResourceModel struct {
+	SpaceID       types.String `tfsdk:"space_id"`
+	TenantID      types.String `tfsdk:"tenant_id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	TemplateID    types.String `tfsdk:"template_id"`
+	Value         types.String `tfsdk:"value"`
+	IsSensitive   types.Bool   `tfsdk:"is_sensitive"`
+}
+
+func (r *TenantProjectVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tenant_project_variable"
+}
+
+func (r *TenantProjectVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to set the value of a single project template on a tenant for one environment, leaving the other variables of the tenant untouched",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the tenant belongs to",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the tenant to set the value on",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project which defines the template",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the environment the value applies to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the template to set the value of",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the variable. Values of sensitive templates are write-only, so changes made outside of Terraform are not detected",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"is_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the template is sensitive",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *TenantProjectVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// set writes the planned value, returning whether the template is sensitive.
+func (r *TenantProjectVariableResource) set(ctx context.Context, plan TenantProjectVariableResourceModel) (bool, error) {
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+	tenantID := plan.TenantID.ValueString()
+	projectID := plan.ProjectID.ValueString()
+	environmentID := plan.EnvironmentID.ValueString()
+	templateID := plan.TemplateID.ValueString()
+
+	var isSensitive bool
+	_, err := updateTenantVariables(ctx, custom.NewClient(r.client, r.readOnly), spaceID, tenantID, func(tenantVariables *variables.TenantVariables) (bool, error) {
+		project, ok := tenantVariables.ProjectVariables[projectID]
+		if !ok {
+			return false, fmt.Errorf("project %s is not connected to tenant %s", projectID, tenantID)
+		}
+
+		template := findTemplate(project.Templates, templateID)
+		if template == nil {
+			return false, fmt.Errorf("template %s not found in project %s", templateID, projectID)
+		}
+
+		isSensitive = isSensitiveTemplate(template)
+		if project.Variables == nil {
+			project.Variables = map[string]map[string]core.PropertyValue{}
+		}
+
+		if project.Variables[environmentID] == nil {
+			project.Variables[environmentID] = map[string]core.PropertyValue{}
+		}
+
+		project.Variables[environmentID][templateID] = expandTenantVariableValue(template, plan.Value.ValueString())
+		tenantVariables.ProjectVariables[projectID] = project
+		return true, nil
+	})
+
+	return isSensitive, err
+}
+
+func (r *TenantProjectVariableResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create tenant project variable"))
+		return
+	}
+
+	var plan TenantProjectVariableResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "setting tenant project variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	isSensitive, err := r.set(ctx, plan)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to set tenant project variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "set tenant project variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	plan.IsSensitive = types.BoolValue(isSensitive)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantProjectVariableResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state TenantProjectVariableResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tenantID := state.TenantID.ValueString()
+	projectID := state.ProjectID.ValueString()
+	environmentID := state.EnvironmentID.ValueString()
+	templateID := state.TemplateID.ValueString()
+
+	tflog.Debug(ctx, "fetching tenant variables", map[string]interface{}{"tenant_id": tenantID, "space_id": spaceID})
+
+	tenantVariables, err := custom.NewClient(r.client, r.readOnly).GetTenantVariables(ctx, spaceID, tenantID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get tenant variables", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	project, ok := tenantVariables.ProjectVariables[projectID]
+	if !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	template := findTemplate(project.Templates, templateID)
+	propertyValue, ok := project.Variables[environmentID][templateID]
+	if template == nil || !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	value, ok := flattenTenantVariableValue(propertyValue, state.Value)
+	if !ok {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	state.SpaceID = types.StringValue(spaceID)
+	state.Value = value
+	state.IsSensitive = types.BoolValue(isSensitiveTemplate(template))
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantProjectVariableResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update tenant project variable"))
+		return
+	}
+
+	var plan TenantProjectVariableResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "setting tenant project variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	isSensitive, err := r.set(ctx, plan)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to set tenant project variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "set tenant project variable", map[string]interface{}{"tenant_id": plan.TenantID.ValueString(), "template_id": plan.TemplateID.ValueString()})
+
+	plan.SpaceID = types.StringValue(resolveSpaceID(r.client, plan.SpaceID.ValueString()))
+	plan.IsSensitive = types.BoolValue(isSensitive)
+
+	if res.Diagnostics.Append(res.State.Set(ctx, plan)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TenantProjectVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete tenant project variable"))
+		return
+	}
+
+	var state TenantProjectVariableResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	tenantID := state.TenantID.ValueString()
+	projectID := state.ProjectID.ValueString()
+	environmentID := state.EnvironmentID.ValueString()
+	templateID := state.TemplateID.ValueString()
+
+	tflog.Debug(ctx, "removing tenant project variable", map[string]interface{}{"tenant_id": tenantID, "template_id": templateID})
+
+	_, err := updateTenantVariables(ctx, custom.NewClient(r.client, r.readOnly), spaceID, tenantID, func(tenantVariables *variables.TenantVariables) (bool, error) {
+		project, ok := tenantVariables.ProjectVariables[projectID]
+		if !ok {
+			return false, nil
+		}
+
+		if _, ok := project.Variables[environmentID][templateID]; !ok {
+			return false, nil
+		}
+
+		delete(project.Variables[environmentID], templateID)
+		tenantVariables.ProjectVariables[projectID] = project
+		return true, nil
+	})
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to remove tenant project variable", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "removed tenant project variable", map[string]interface{}{"tenant_id": tenantID, "template_id": templateID})
+}
+
+func (r *TenantProjectVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	parts := strings.Split(id, ":")
+	if len(parts) != 4 {
+		res.Diagnostics.AddError(
+			"Error importing tenant project variable",
+			"ID should be in the form [space_id/]tenant_id:project_id:environment_id:template_id",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "imported tenant project variable", map[string]interface{}{
+		"space_id":       spaceID,
+		"tenant_id":      parts[0],
+		"project_id":     parts[1],
+		"environment_id": parts[2],
+		"template_id":    parts[3],
+	})
+
+	// the value is filled in by the read which follows the import, except for
+	// sensitive values which are never returned
+	model := TenantProjectVariableResourceModel{
+		SpaceID:       types.StringValue(resolveSpaceID(r.client, spaceID)),
+		TenantID:      types.StringValue(parts[0]),
+		ProjectID:     types.StringValue(parts[1]),
+		EnvironmentID: types.StringValue(parts[2]),
+		TemplateID:    types.StringValue(parts[3]),
+		Value:         types.StringValue(""),
+		IsSensitive:   types.BoolValue(false),
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, &model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = (*WorkerResource)(nil)
+	_ resource.ResourceWithConfigure      = (*WorkerResource)(nil)
+	_ resource.ResourceWithImportState    = (*WorkerResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*WorkerResource)(nil)
+)
+
+// tentacleCommunicationStyles maps how a Tentacle communicates to the
+// communication style of its endpoint.
+var tentacleCommunicationStyles = map[string]string{
+	"Listening": "TentaclePassive",
+	"Polling":   "TentacleActive",
+}
+
+// tentacleURIPattern matches the URI of a listening or polling Tentacle.
+var tentacleURIPattern = regexp.MustCompile(`^(https|poll)://`)
+
+// tentacleURISchemes maps how a Tentacle communicates to the scheme of its URI.
+var tentacleURISchemes = map[string]string{
+	"Listening": "https://",
+	"Polling":   "poll://",
+}
+
+// expandTentacleEndpoint converts the communication style, URI and thumbprint
+// of a Tentacle to an endpoint.
+func expandTentacleEndpoint(communicationStyle string, uri, thumbprint types.String) *custom.MachineEndpoint {
+	return &custom.MachineEndpoint{
+		CommunicationStyle: tentacleCommunicationStyles[communicationStyle],
+		URI:                uri.ValueString(),
+		Thumbprint:         thumbprint.ValueString(),
+	}
+}
+
+// flattenTentacleCommunicationStyle converts the communication style of an
+// endpoint back to how the Tentacle communicates, reporting false when the
+// endpoint is not a Tentacle.
+func flattenTentacleCommunicationStyle(endpoint *custom.MachineEndpoint) (types.String, bool) {
+	if endpoint == nil {
+		return types.StringNull(), false
+	}
+
+	for name, style := range tentacleCommunicationStyles {
+		if endpoint.CommunicationStyle == style {
+			return types.StringValue(name), true
+		}
+	}
+
+	return types.StringNull(), false
+}
+
+func tentacleCommunicationStyleNames() []string {
+	return []string{"Listening", "Polling"}
+}
+
+func NewWorkerResource() resource.Resource {
+	return &WorkerResource{}
+}
+
+// WorkerResource defines the resource implementation.
+type WorkerResource struct {
+	client   *client.Client
+	readOnly bool
+}
+
+// WorkerResourceModel describes the resource data model.
+type WorkerResourceModel struct {
+	SpaceID            types.String `tfsdk:"space_id"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	CommunicationStyle types.String `tfsdk:"communication_style"`
+	URI                types.String `tfsdk:"uri"`
+	Thumbprint         types.String `tfsdk:"thumbprint"`
+	MachinePolicyID    types.String `tfsdk:"machine_policy_id"`
+	WorkerPoolIDs      types.Set    `tfsdk:"worker_pool_ids"`
+	IsDisabled         types.Bool   `tfsdk:"is_disabled"`
+}
+
+// expandWorkerResourceModel converts the model to a worker.
+func expandWorkerResourceModel(ctx context.Context, model WorkerResourceModel) (*custom.Worker, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	worker := custom.Worker{
+		SpaceID:    model.SpaceID.ValueString(),
+		ID:         model.ID.ValueString(),
+		Name:       model.Name.ValueString(),
+		Thumbprint: model.Thumbprint.ValueString(),
+		URI:        model.URI.ValueString(),
+		IsDisabled: model.IsDisabled.ValueBool(),
+		Endpoint:   expandTentacleEndpoint(model.CommunicationStyle.ValueString(), model.URI, model.Thumbprint),
+	}
+
+	// the space's default policy is assigned until one is known
+	if !model.MachinePolicyID.IsUnknown() {
+		worker.MachinePolicyID = model.MachinePolicyID.ValueString()
+	}
+
+	var nestedDiags diag.Diagnostics
+	worker.WorkerPoolIDs, nestedDiags = expandStringSet(ctx, model.WorkerPoolIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &worker, diags
+}
+
+// flattenWorkerResourceModel converts the worker to a model.
+func flattenWorkerResourceModel(ctx context.Context, worker *custom.Worker) (*WorkerResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	communicationStyle, ok := flattenTentacleCommunicationStyle(worker.Endpoint)
+	if !ok {
+		diags.AddError("Unsupported worker", fmt.Sprintf("worker %s is not a listening or polling Tentacle", worker.ID))
+		return nil, diags
+	}
+
+	model := WorkerResourceModel{
+		SpaceID:            types.StringValue(worker.SpaceID),
+		ID:                 types.StringValue(worker.ID),
+		Name:               types.StringValue(worker.Name),
+		CommunicationStyle: communicationStyle,
+		URI:                types.StringValue(worker.Endpoint.URI),
+		Thumbprint:         types.StringValue(worker.Endpoint.Thumbprint),
+		MachinePolicyID:    types.StringValue(worker.MachinePolicyID),
+		IsDisabled:         types.BoolValue(worker.IsDisabled),
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.WorkerPoolIDs, nestedDiags = flattenStringSet(ctx, worker.WorkerPoolIDs)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+func (r *WorkerResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_worker"
+}
+
+func (r *WorkerResource) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to register a listening or polling Tentacle as a worker in one or more static worker pools",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the space that the worker belongs to",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the worker",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the worker",
+				Required:            true,
+			},
+			"communication_style": schema.StringAttribute{
+				MarkdownDescription: "How the Tentacle communicates with Octopus, either `Listening` or `Polling`",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf(tentacleCommunicationStyleNames()...)},
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "The address of a listening Tentacle, such as `https://worker-1:10933/`, or the subscription of a polling Tentacle, such as `poll://abcdefghijklmnopqrst/`",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.RegexMatches(tentacleURIPattern, "must start with https:// or poll://")},
+			},
+			"thumbprint": schema.StringAttribute{
+				MarkdownDescription: "The thumbprint of the Tentacle's certificate",
+				Required:            true,
+			},
+			"machine_policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the machine policy of the worker. The space's default policy is used when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"worker_pool_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the static worker pools the worker belongs to",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the worker is disabled, which stops work being run on it",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the URI matches the communication style, as
+// listening Tentacles are reached over https:// and polling Tentacles
+// subscribe with poll://.
+func (r *WorkerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var communicationStyle, uri types.String
+	if res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("communication_style"), &communicationStyle)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("uri"), &uri)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if communicationStyle.IsNull() || communicationStyle.IsUnknown() || uri.IsNull() || uri.IsUnknown() {
+		return
+	}
+
+	scheme, ok := tentacleURISchemes[communicationStyle.ValueString()]
+	if !ok || strings.HasPrefix(uri.ValueString(), scheme) {
+		return
+	}
+
+	res.Diagnostics.AddAttributeError(
+		path.Root("uri"),
+		"Invalid Tentacle URI",
+		fmt.Sprintf("The URI of a %s worker must start with %s", strings.ToLower(communicationStyle.ValueString()), scheme),
+	)
+}
+
+func (r *WorkerResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *WorkerResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("create worker"))
+		return
+	}
+
+	var plan WorkerResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	worker, diags := expandWorkerResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	worker.SpaceID = resolveSpaceID(r.client, worker.SpaceID)
+
+	tflog.Debug(ctx, "creating worker", map[string]interface{}{"worker": worker})
+
+	worker, err := custom.NewClient(r.client, r.readOnly).CreateWorker(ctx, *worker)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to create worker", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "created worker", map[string]interface{}{"worker": worker})
+
+	model, diags := flattenWorkerResourceModel(ctx, worker)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkerResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state WorkerResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "fetching worker", map[string]interface{}{"id": workerID, "space_id": spaceID})
+
+	worker, err := custom.NewClient(r.client, r.readOnly).GetWorker(ctx, spaceID, workerID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "fetched worker", map[string]interface{}{"worker": worker})
+
+	model, diags := flattenWorkerResourceModel(ctx, worker)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkerResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("update worker"))
+		return
+	}
+
+	var plan WorkerResourceModel
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	worker, diags := expandWorkerResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	worker.SpaceID = resolveSpaceID(r.client, worker.SpaceID)
+
+	tflog.Debug(ctx, "updating worker", map[string]interface{}{"worker": worker})
+
+	worker, err := custom.NewClient(r.client, r.readOnly).UpdateWorker(ctx, *worker)
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to update worker", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "updated worker", map[string]interface{}{"worker": worker})
+
+	model, diags := flattenWorkerResourceModel(ctx, worker)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkerResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider("delete worker"))
+		return
+	}
+
+	var state WorkerResourceModel
+	if res.Diagnostics.Append(req.State.Get(ctx, &state)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	workerID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting worker", map[string]interface{}{"id": workerID, "space_id": spaceID})
+
+	err := custom.NewClient(r.client, r.readOnly).DeleteWorker(ctx, spaceID, workerID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to delete worker", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleted worker", map[string]interface{}{"id": workerID})
+}
+
+func (r *WorkerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	spaceID = resolveSpaceID(r.client, spaceID)
+
+	tflog.Debug(ctx, "importing worker", map[string]interface{}{"id": id, "space_id": spaceID})
+
+	worker, err := custom.NewClient(r.client, r.readOnly).GetWorker(ctx, spaceID, id)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic("Worker not found", err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic("Failed to get worker", err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := flattenWorkerResourceModel(ctx, worker)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	if res.Diagnostics.Append(res.State.Set(ctx, model)...); res.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWorkerResourceValidateConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		communicationStyle tftypes.Value
+		uri                tftypes.Value
+		valid              bool
+	}{
+		{"listening https", tftypes.NewValue(tftypes.String, "Listening"), tftypes.NewValue(tftypes.String, "https://worker-1:10933/"), true},
+		{"polling poll", tftypes.NewValue(tftypes.String, "Polling"), tftypes.NewValue(tftypes.String, "poll://abcdefghijklmnopqrst/"), true},
+		{"listening poll", tftypes.NewValue(tftypes.String, "Listening"), tftypes.NewValue(tftypes.String, "poll://abcdefghijklmnopqrst/"), false},
+		{"polling https", tftypes.NewValue(tftypes.String, "Polling"), tftypes.NewValue(tftypes.String, "https://worker-1:10933/"), false},
+		{"unknown uri", tftypes.NewValue(tftypes.String, "Polling"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), true},
+		{"unknown communication style", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "https://worker-1:10933/"), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewWorkerResource()
+			req := resource.ValidateConfigRequest{
+				Config: testResourceConfig(t, r, map[string]tftypes.Value{
+					"communication_style": test.communicationStyle,
+					"uri":                 test.uri,
+				}),
+			}
+
+			res := &resource.ValidateConfigResponse{}
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, res)
+
+			if test.valid && res.Diagnostics.HasError() {
+				t.Errorf("expected a valid config, got %v", res.Diagnostics)
+			}
+
+			if !test.valid && !res.Diagnostics.HasError() {
+				t.Error("expected an invalid config")
+			}
+		})
+	}
+}
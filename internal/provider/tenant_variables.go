@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/axatol/terraform-provider-octopusdeploycontrib/internal/custom"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// updateTenantVariables fetches the variables of the tenant, applies update
+// and writes them back if update reports a change. Sensitive values which are
+// not changed are sent back without a new value, which leaves them as they
+// are.
+func updateTenantVariables(ctx context.Context, client *custom.Client, spaceID, tenantID string, update func(*variables.TenantVariables) (bool, error)) (*variables.TenantVariables, error) {
+	defer lockResource(spaceID, "tenantvariables", tenantID)()
+
+	tflog.Debug(ctx, "fetching tenant variables", map[string]interface{}{"tenant_id": tenantID, "space_id": spaceID})
+
+	tenantVariables, err := client.GetTenantVariables(ctx, spaceID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := update(tenantVariables)
+	if err != nil || !changed {
+		return tenantVariables, err
+	}
+
+	tflog.Debug(ctx, "updating tenant variables", map[string]interface{}{"tenant_id": tenantID, "space_id": spaceID})
+
+	return client.UpdateTenantVariables(ctx, spaceID, *tenantVariables)
+}
+
+// findTemplate returns the template with the given ID, or nil when there is
+// no such template.
+func findTemplate(templates []*actiontemplates.ActionTemplateParameter, id string) *actiontemplates.ActionTemplateParameter {
+	for _, template := range templates {
+		if template != nil && template.ID == id {
+			return template
+		}
+	}
+
+	return nil
+}
+
+// isSensitiveTemplate reports whether values of the template are sensitive.
+func isSensitiveTemplate(template *actiontemplates.ActionTemplateParameter) bool {
+	return template.DisplaySettings[controlTypeDisplaySetting] == string(resources.ControlTypeSensitive)
+}
+
+// expandTenantVariableValue converts a value to the property value sent for
+// the template.
+func expandTenantVariableValue(template *actiontemplates.ActionTemplateParameter, value string) core.PropertyValue {
+	return core.NewPropertyValue(value, isSensitiveTemplate(template))
+}
+
+// flattenTenantVariableValue converts the property value to a model value,
+// reporting false when no value is set. The server never returns sensitive
+// values, so they are carried over from the prior value.
+func flattenTenantVariableValue(propertyValue core.PropertyValue, prior types.String) (types.String, bool) {
+	if propertyValue.IsSensitive {
+		if propertyValue.SensitiveValue == nil || !propertyValue.SensitiveValue.HasValue {
+			return types.StringNull(), false
+		}
+
+		return prior, true
+	}
+
+	return types.StringValue(propertyValue.Value), true
+}
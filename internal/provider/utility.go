@@ -2,11 +2,19 @@ package provider
 
 import (
 	"context"
+	"regexp"
+	"sync"
 
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// pageSize is the number of items requested per page when listing resources.
+const pageSize = 100
+
 func expandStringList(ctx context.Context, in types.List) ([]string, diag.Diagnostics) {
 	count := len(in.Elements())
 	vals := make([]types.String, 0, count)
@@ -35,3 +43,119 @@ func flattenStringList(ctx context.Context, in []string) (types.List, diag.Diagn
 	out, diags := types.ListValueFrom(ctx, types.StringType, in)
 	return out, diags
 }
+
+// matchNestedIDs assigns the IDs of existing nested objects, such as the tags
+// of a tag set, to planned ones. Objects keep their ID when they are moved,
+// matched by name, or when they are renamed in place, matched by position.
+// Planned objects matching no existing object are left with a null ID.
+func matchNestedIDs[T any](planned, existing []T, key func(T) (id, name types.String)) []types.String {
+	ids := make([]types.String, len(planned))
+	claimed := make([]bool, len(existing))
+
+	for i, item := range planned {
+		_, name := key(item)
+		for j, prior := range existing {
+			priorID, priorName := key(prior)
+			if !claimed[j] && priorName.Equal(name) {
+				ids[i] = priorID
+				claimed[j] = true
+				break
+			}
+		}
+	}
+
+	for i := range planned {
+		if ids[i].IsNull() && i < len(existing) && !claimed[i] {
+			ids[i], _ = key(existing[i])
+			claimed[i] = true
+		}
+	}
+
+	return ids
+}
+
+var importIDSpacePrefix = regexp.MustCompile(`^(Spaces-\d+)/(.+)$`)
+
+// parseImportID splits an import ID of the form [Spaces-N/]remainder into the
+// optional space ID and the remainder.
+func parseImportID(id string) (spaceID, remainder string) {
+	if matches := importIDSpacePrefix.FindStringSubmatch(id); matches != nil {
+		return matches[1], matches[2]
+	}
+
+	return "", id
+}
+
+// resolveSpaceID returns the given space ID, falling back to the space the
+// client was configured with.
+func resolveSpaceID(client *client.Client, spaceID string) string {
+	if spaceID == "" {
+		return client.GetSpaceID()
+	}
+
+	return spaceID
+}
+
+// getAllPages calls get with an increasing offset until every item has been
+// fetched.
+func getAllPages[T any](ctx context.Context, get func(skip, take int) (*resources.Resources[T], error)) ([]T, error) {
+	items := []T{}
+	for {
+		tflog.Debug(ctx, "fetching page", map[string]interface{}{"skip": len(items), "take": pageSize})
+
+		page, err := get(len(items), pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+		if len(page.Items) == 0 || len(items) >= page.TotalResults {
+			return items, nil
+		}
+	}
+}
+
+func expandStringSet(ctx context.Context, in types.Set) ([]string, diag.Diagnostics) {
+	out := []string{}
+	if len(in.Elements()) < 1 {
+		return out, nil
+	}
+
+	diags := in.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+func flattenStringSet(ctx context.Context, in []string) (types.Set, diag.Diagnostics) {
+	if in == nil {
+		in = []string{}
+	}
+
+	out, diags := types.SetValueFrom(ctx, types.StringType, in)
+	return out, diags
+}
+
+// resourceLocks holds a mutex per resource which is read, modified and written
+// back as a whole by more than one Terraform resource.
+var resourceLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: map[string]*sync.Mutex{}}
+
+// lockResource locks the resource of a kind with the given ID, returning a
+// function which unlocks it. Resources which are read, modified and written
+// back as a whole are locked while doing so, so that changes made by this
+// provider instance are serialised.
+func lockResource(spaceID, kind, id string) (unlock func()) {
+	key := spaceID + "/" + kind + "/" + id
+
+	resourceLocks.Lock()
+	lock, ok := resourceLocks.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		resourceLocks.locks[key] = lock
+	}
+	resourceLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
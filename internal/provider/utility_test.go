@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchNestedIDs(t *testing.T) {
+	type item struct {
+		id   types.String
+		name string
+	}
+
+	existing := func(names ...string) []item {
+		items := make([]item, len(names))
+		for i, name := range names {
+			items[i] = item{id: types.StringValue("id-" + name), name: name}
+		}
+
+		return items
+	}
+
+	planned := func(names ...string) []item {
+		items := make([]item, len(names))
+		for i, name := range names {
+			items[i] = item{id: types.StringUnknown(), name: name}
+		}
+
+		return items
+	}
+
+	tests := []struct {
+		name     string
+		planned  []item
+		existing []item
+		want     []string
+	}{
+		{
+			name:     "unchanged",
+			planned:  planned("a", "b", "c"),
+			existing: existing("a", "b", "c"),
+			want:     []string{"id-a", "id-b", "id-c"},
+		},
+		{
+			name:     "rename in place",
+			planned:  planned("a", "x", "c"),
+			existing: existing("a", "b", "c"),
+			want:     []string{"id-a", "id-b", "id-c"},
+		},
+		{
+			name:     "move",
+			planned:  planned("c", "a", "b"),
+			existing: existing("a", "b", "c"),
+			want:     []string{"id-c", "id-a", "id-b"},
+		},
+		{
+			name:     "move and rename",
+			planned:  planned("c", "x", "a"),
+			existing: existing("a", "b", "c"),
+			want:     []string{"id-c", "id-b", "id-a"},
+		},
+		{
+			name:     "rename into a position claimed by a move",
+			planned:  planned("c", "a", "x"),
+			existing: existing("a", "b", "c"),
+			want:     []string{"id-c", "id-a", ""},
+		},
+		{
+			name:     "append",
+			planned:  planned("a", "b", "x"),
+			existing: existing("a", "b"),
+			want:     []string{"id-a", "id-b", ""},
+		},
+		{
+			name:     "remove",
+			planned:  planned("b"),
+			existing: existing("a", "b"),
+			want:     []string{"id-b"},
+		},
+		{
+			name:     "create",
+			planned:  planned("a"),
+			existing: nil,
+			want:     []string{""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids := matchNestedIDs(test.planned, test.existing, func(i item) (types.String, types.String) {
+				return i.id, types.StringValue(i.name)
+			})
+
+			if len(ids) != len(test.want) {
+				t.Fatalf("expected %d IDs, got %d", len(test.want), len(ids))
+			}
+
+			for i, id := range ids {
+				want := types.StringNull()
+				if test.want[i] != "" {
+					want = types.StringValue(test.want[i])
+				}
+
+				if !id.Equal(want) {
+					t.Errorf("expected ID %d to be %s, got %s", i, want, id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var nugetVersionPattern = regexp.MustCompile(`^\d+(\.\d+){0,3}(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// nugetVersion is a parsed NuGet version. Build metadata is dropped, as it
+// does not affect the order of versions.
+type nugetVersion struct {
+	release    []int
+	prerelease []string
+}
+
+// parseNuGetVersion parses a NuGet version, padding the release to four parts
+// so that versions such as 1.0 and 1.0.0 are equal.
+func parseNuGetVersion(version string) (*nugetVersion, error) {
+	if !nugetVersionPattern.MatchString(version) {
+		return nil, fmt.Errorf("%q is not a valid version", version)
+	}
+
+	release, prerelease, _ := strings.Cut(strings.SplitN(version, "+", 2)[0], "-")
+	parsed := &nugetVersion{}
+	for _, part := range strings.Split(release, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid version", version)
+		}
+
+		parsed.release = append(parsed.release, number)
+	}
+
+	for len(parsed.release) < 4 {
+		parsed.release = append(parsed.release, 0)
+	}
+
+	if prerelease != "" {
+		parsed.prerelease = strings.Split(prerelease, ".")
+	}
+
+	return parsed, nil
+}
+
+// compareNuGetVersions returns a negative number when a is below b, a positive
+// number when a is above b, or zero when they are equal. A pre-release is below
+// its release, and pre-release labels are compared part by part, numerically
+// when both parts are numbers and case-insensitively otherwise.
+func compareNuGetVersions(a, b *nugetVersion) int {
+	for i := range a.release {
+		if a.release[i] != b.release[i] {
+			return a.release[i] - b.release[i]
+		}
+	}
+
+	if len(a.prerelease) == 0 || len(b.prerelease) == 0 {
+		return len(b.prerelease) - len(a.prerelease)
+	}
+
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		x, xErr := strconv.Atoi(a.prerelease[i])
+		y, yErr := strconv.Atoi(b.prerelease[i])
+		switch {
+		case xErr == nil && yErr == nil:
+			if x != y {
+				return x - y
+			}
+		case xErr == nil:
+			return -1
+		case yErr == nil:
+			return 1
+		default:
+			if comparison := strings.Compare(strings.ToLower(a.prerelease[i]), strings.ToLower(b.prerelease[i])); comparison != 0 {
+				return comparison
+			}
+		}
+	}
+
+	return len(a.prerelease) - len(b.prerelease)
+}
+
+// checkNuGetVersionRange reports why the version range is invalid, such as
+// `1.0`, `[1.0]`, `(,2.0)` or `[1.0,2.0)`, or nil when it is valid.
+func checkNuGetVersionRange(versionRange string) error {
+	versionRange = strings.TrimSpace(versionRange)
+	if versionRange == "" {
+		return fmt.Errorf("version range is empty")
+	}
+
+	opening, closing := versionRange[0], versionRange[len(versionRange)-1]
+	if opening != '[' && opening != '(' {
+		_, err := parseNuGetVersion(versionRange)
+		return err
+	}
+
+	if len(versionRange) < 2 || (closing != ']' && closing != ')') {
+		return fmt.Errorf("version range must end with ] or )")
+	}
+
+	minVersion, maxVersion, isInterval := strings.Cut(versionRange[1:len(versionRange)-1], ",")
+	minVersion, maxVersion = strings.TrimSpace(minVersion), strings.TrimSpace(maxVersion)
+	if !isInterval {
+		if opening != '[' || closing != ']' {
+			return fmt.Errorf("an exact version must be in the form [1.0]")
+		}
+
+		_, err := parseNuGetVersion(minVersion)
+		return err
+	}
+
+	if minVersion == "" && maxVersion == "" {
+		return fmt.Errorf("version range must have a lower or upper bound")
+	}
+
+	var lower, upper *nugetVersion
+	var err error
+	if minVersion != "" {
+		if lower, err = parseNuGetVersion(minVersion); err != nil {
+			return err
+		}
+	}
+
+	if maxVersion != "" {
+		if upper, err = parseNuGetVersion(maxVersion); err != nil {
+			return err
+		}
+	}
+
+	if lower == nil || upper == nil {
+		return nil
+	}
+
+	comparison := compareNuGetVersions(lower, upper)
+	if comparison > 0 || (comparison == 0 && (opening != '[' || closing != ']')) {
+		return fmt.Errorf("lower bound %s must be below upper bound %s", minVersion, maxVersion)
+	}
+
+	return nil
+}
+
+var _ validator.String = nugetVersionRangeValidator{}
+
+// nugetVersionRangeValidator validates that a string is a NuGet version range.
+// Empty strings are allowed, meaning any version.
+type nugetVersionRangeValidator struct{}
+
+func (v nugetVersionRangeValidator) Description(ctx context.Context) string {
+	return "value must be a NuGet version range, such as [1.0,2.0)"
+}
+
+func (v nugetVersionRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a NuGet version range, such as `[1.0,2.0)`"
+}
+
+func (v nugetVersionRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if err := checkNuGetVersionRange(req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid version range", err.Error())
+	}
+}
+
+// validNuGetVersionRange returns a validator which checks that a string is a
+// NuGet version range.
+func validNuGetVersionRange() validator.String {
+	return nugetVersionRangeValidator{}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import "testing"
+
+func TestCheckNuGetVersionRange(t *testing.T) {
+	tests := []struct {
+		versionRange string
+		valid        bool
+	}{
+		{"1.0", true},
+		{"1.0.0.0", true},
+		{"1.0.0-beta+build", true},
+		{"[1.0]", true},
+		{"(1.0)", false},
+		{"[1.0)", false},
+		{"(,2.0)", true},
+		{"[1.0,)", true},
+		{"[1.0,2.0)", true},
+		{"[1.0,1.0]", true},
+		{"[1.0,1.0.0]", true},
+		{"[2.0,1.0]", false},
+		{"[1.0,1.0)", false},
+		{"(1.0,1.0]", false},
+		{"[1.0,1.0.0)", false},
+		{"[1.0.0-beta,1.0.0)", true},
+		{"[1.0.0,1.0.0-beta)", false},
+		{"[1.0.0-beta.2,1.0.0-beta.10)", true},
+		{"[1.0.0-beta.10,1.0.0-beta.2)", false},
+		{"[1.0.0-1,1.0.0-alpha)", true},
+		{"[1.0.0-alpha,1.0.0-alpha.1)", true},
+		{"[1.0.0-BETA,1.0.0-beta]", true},
+		{"(,)", false},
+		{"", false},
+		{"[1.0,2.0", false},
+		{"abc", false},
+		{"[1.0,abc)", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.versionRange, func(t *testing.T) {
+			err := checkNuGetVersionRange(test.versionRange)
+			if test.valid && err != nil {
+				t.Errorf("expected %q to be valid, got %s", test.versionRange, err)
+			}
+
+			if !test.valid && err == nil {
+				t.Errorf("expected %q to be invalid", test.versionRange)
+			}
+		})
+	}
+}
@@ -0,0 +1,698 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// variableTypes are the types of value a variable can hold.
+var variableTypes = []string{
+	"String",
+	"Sensitive",
+	"Certificate",
+	"AmazonWebServicesAccount",
+	"AzureAccount",
+	"GoogleCloudAccount",
+	"GenericOidcAccount",
+	"UsernamePasswordAccount",
+	"WorkerPool",
+}
+
+// updateVariableSet fetches the variable set of the owner, applies update and
+// writes the set back if update reports a change. The version of the fetched
+// set is sent back, so a concurrent change made elsewhere fails rather than
+// being overwritten.
+func updateVariableSet(ctx context.Context, client *client.Client, spaceID, ownerID string, update func(*variables.VariableSet) (bool, error)) (*variables.VariableSet, error) {
+	defer lockResource(spaceID, "variableset", ownerID)()
+
+	tflog.Debug(ctx, "fetching variable set", map[string]interface{}{"owner_id": ownerID, "space_id": spaceID})
+
+	variableSet, err := variables.GetAll(client, spaceID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := update(&variableSet)
+	if err != nil || !changed {
+		return &variableSet, err
+	}
+
+	tflog.Debug(ctx, "updating variable set", map[string]interface{}{"owner_id": ownerID, "space_id": spaceID, "version": variableSet.Version})
+
+	variableSet, err = variables.Update(client, spaceID, ownerID, variableSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &variableSet, nil
+}
+
+// findVariable returns the variable with the given ID, or nil when the set
+// does not contain it.
+func findVariable(variableSet *variables.VariableSet, id string) *variables.Variable {
+	for _, variable := range variableSet.Variables {
+		if variable.ID == id {
+			return variable
+		}
+	}
+
+	return nil
+}
+
+// replaceVariable swaps the variable with the same ID as the given variable,
+// returning an error when the set does not contain it.
+func replaceVariable(variableSet *variables.VariableSet, variable *variables.Variable) error {
+	for i, existing := range variableSet.Variables {
+		if existing.ID == variable.ID {
+			variableSet.Variables[i] = variable
+			return nil
+		}
+	}
+
+	return fmt.Errorf("variable %s not found in variable set %s", variable.ID, variableSet.ID)
+}
+
+// removeVariable removes the variable with the given ID, reporting whether it
+// was present.
+func removeVariable(variableSet *variables.VariableSet, id string) bool {
+	for i, existing := range variableSet.Variables {
+		if existing.ID == id {
+			variableSet.Variables = append(variableSet.Variables[:i], variableSet.Variables[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// VariableScopeResourceModel describes the scope of a variable.
+type VariableScopeResourceModel struct {
+	EnvironmentIDs types.Set `tfsdk:"environment_ids"`
+	TenantTags     types.Set `tfsdk:"tenant_tags"`
+	Roles          types.Set `tfsdk:"roles"`
+	MachineIDs     types.Set `tfsdk:"machine_ids"`
+	ChannelIDs     types.Set `tfsdk:"channel_ids"`
+	ActionIDs      types.Set `tfsdk:"action_ids"`
+	ProcessIDs     types.Set `tfsdk:"process_ids"`
+}
+
+// VariablePromptResourceModel describes the prompt shown for a variable when
+// creating a release or running a runbook.
+type VariablePromptResourceModel struct {
+	Label         types.String                        `tfsdk:"label"`
+	Description   types.String                        `tfsdk:"description"`
+	IsRequired    types.Bool                          `tfsdk:"is_required"`
+	ControlType   types.String                        `tfsdk:"control_type"`
+	SelectOptions []VariableSelectOptionResourceModel `tfsdk:"select_options"`
+}
+
+// VariableSelectOptionResourceModel describes an option of a select prompt.
+type VariableSelectOptionResourceModel struct {
+	Value       types.String `tfsdk:"value"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+// expandVariableScope converts the model to a variable scope.
+func expandVariableScope(ctx context.Context, model *VariableScopeResourceModel) (variables.VariableScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scope := variables.VariableScope{}
+	if model == nil {
+		return scope, diags
+	}
+
+	fields := []struct {
+		in  types.Set
+		out *[]string
+	}{
+		{model.EnvironmentIDs, &scope.Environments},
+		{model.TenantTags, &scope.TenantTags},
+		{model.Roles, &scope.Roles},
+		{model.MachineIDs, &scope.Machines},
+		{model.ChannelIDs, &scope.Channels},
+		{model.ActionIDs, &scope.Actions},
+		{model.ProcessIDs, &scope.ProcessOwners},
+	}
+
+	for _, field := range fields {
+		values, nestedDiags := expandStringSet(ctx, field.in)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return scope, diags
+		}
+
+		if len(values) > 0 {
+			*field.out = values
+		}
+	}
+
+	return scope, diags
+}
+
+// flattenVariableScope converts the variable scope to a model. Empty scopes
+// and scope values are null so they match an omitted configuration.
+func flattenVariableScope(ctx context.Context, scope variables.VariableScope) (*VariableScopeResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if scope.IsEmpty() {
+		return nil, diags
+	}
+
+	model := VariableScopeResourceModel{}
+	fields := []struct {
+		in  []string
+		out *types.Set
+	}{
+		{scope.Environments, &model.EnvironmentIDs},
+		{scope.TenantTags, &model.TenantTags},
+		{scope.Roles, &model.Roles},
+		{scope.Machines, &model.MachineIDs},
+		{scope.Channels, &model.ChannelIDs},
+		{scope.Actions, &model.ActionIDs},
+		{scope.ProcessOwners, &model.ProcessIDs},
+	}
+
+	for _, field := range fields {
+		if len(field.in) < 1 {
+			*field.out = types.SetNull(types.StringType)
+			continue
+		}
+
+		var nestedDiags diag.Diagnostics
+		*field.out, nestedDiags = flattenStringSet(ctx, field.in)
+		if diags.Append(nestedDiags...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return &model, diags
+}
+
+// expandVariablePrompt converts the model to variable prompt options.
+func expandVariablePrompt(model *VariablePromptResourceModel) *variables.VariablePromptOptions {
+	if model == nil {
+		return nil
+	}
+
+	prompt := &variables.VariablePromptOptions{
+		Label:       model.Label.ValueString(),
+		Description: model.Description.ValueString(),
+		IsRequired:  model.IsRequired.ValueBool(),
+	}
+
+	if !model.ControlType.IsNull() {
+		prompt.DisplaySettings = resources.NewDisplaySettings(resources.ControlType(model.ControlType.ValueString()), nil)
+		for _, option := range model.SelectOptions {
+			prompt.DisplaySettings.SelectOptions = append(prompt.DisplaySettings.SelectOptions, &resources.SelectOption{
+				Value:       option.Value.ValueString(),
+				DisplayName: option.DisplayName.ValueString(),
+			})
+		}
+	}
+
+	return prompt
+}
+
+// flattenVariablePrompt converts variable prompt options to a model.
+func flattenVariablePrompt(prompt *variables.VariablePromptOptions) *VariablePromptResourceModel {
+	if prompt == nil {
+		return nil
+	}
+
+	model := VariablePromptResourceModel{
+		Label:       types.StringValue(prompt.Label),
+		Description: types.StringValue(prompt.Description),
+		IsRequired:  types.BoolValue(prompt.IsRequired),
+		ControlType: types.StringNull(),
+	}
+
+	if prompt.DisplaySettings != nil {
+		model.ControlType = types.StringValue(string(prompt.DisplaySettings.ControlType))
+		for _, option := range prompt.DisplaySettings.SelectOptions {
+			model.SelectOptions = append(model.SelectOptions, VariableSelectOptionResourceModel{
+				Value:       types.StringValue(option.Value),
+				DisplayName: types.StringValue(option.DisplayName),
+			})
+		}
+	}
+
+	return &model
+}
+
+// variableScopeResourceAttribute returns the schema of a variable scope.
+func variableScopeResourceAttribute() schema.SingleNestedAttribute {
+	scopeSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			ElementType:         types.StringType,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Limits where the value applies. Omit rather than leave empty the parts of the scope which are not used",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"environment_ids": scopeSet("IDs of the environments the value applies to"),
+			"tenant_tags":     scopeSet("Canonical names of the tenant tags, in the form `TagSet/Tag`, the value applies to"),
+			"roles":           scopeSet("Target roles the value applies to"),
+			"machine_ids":     scopeSet("IDs of the deployment targets the value applies to"),
+			"channel_ids":     scopeSet("IDs of the channels the value applies to"),
+			"action_ids":      scopeSet("IDs of the deployment process actions the value applies to"),
+			"process_ids":     scopeSet("IDs of the deployment processes or runbooks the value applies to"),
+		},
+	}
+}
+
+// variablePromptResourceAttribute returns the schema of a variable prompt.
+func variablePromptResourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Prompt for a value when creating a release or running a runbook",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label shown for the prompt",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The help text shown for the prompt",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether a value must be provided",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"control_type": schema.StringAttribute{
+				MarkdownDescription: "The control used to enter the value, one of `SingleLineText`, `MultiLineText`, `Checkbox`, `Select` or `Sensitive`",
+				Optional:            true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(resources.ControlTypeSingleLineText),
+					string(resources.ControlTypeMultiLineText),
+					string(resources.ControlTypeCheckbox),
+					string(resources.ControlTypeSelect),
+					string(resources.ControlTypeSensitive),
+				)},
+			},
+			"select_options": schema.ListNestedAttribute{
+				MarkdownDescription: "The options offered when `control_type` is `Select`",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value used when the option is selected",
+							Required:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The name shown for the option",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// VariableResourceModel describes the attributes shared by the resources which
+// manage a single variable in a variable set.
+type VariableResourceModel struct {
+	SpaceID     types.String
+	ID          types.String
+	Name        types.String
+	Type        types.String
+	Value       types.String
+	IsSensitive types.Bool
+	Description types.String
+	Prompt      *VariablePromptResourceModel
+	Scope       *VariableScopeResourceModel
+}
+
+// variableSetOwner describes the owner of the variable set that a variable
+// resource manages a variable in, and converts between the model of the
+// resource, which has an attribute holding the owner ID, and the shared model.
+type variableSetOwner[M any] struct {
+	typeName    string
+	name        string
+	description string
+	attribute   string
+	split       func(M) (VariableResourceModel, string)
+	join        func(VariableResourceModel, string) M
+}
+
+// variableResource implements a resource which manages a single variable in the
+// variable set of its owner, leaving the other variables in the set untouched.
+type variableResource[M any] struct {
+	client   *client.Client
+	readOnly bool
+	owner    variableSetOwner[M]
+}
+
+// expandVariableResourceModel converts the model to a resource.
+func expandVariableResourceModel(ctx context.Context, model VariableResourceModel) (*variables.Variable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource := &variables.Variable{
+		Resource:    resources.Resource{ID: model.ID.ValueString()},
+		Name:        model.Name.ValueString(),
+		Type:        model.Type.ValueString(),
+		Value:       model.Value.ValueString(),
+		IsSensitive: model.Type.ValueString() == "Sensitive",
+		IsEditable:  true,
+		Description: model.Description.ValueString(),
+		Prompt:      expandVariablePrompt(model.Prompt),
+	}
+
+	var nestedDiags diag.Diagnostics
+	resource.Scope, nestedDiags = expandVariableScope(ctx, model.Scope)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return resource, diags
+}
+
+// flattenVariableResourceModel converts the resource to a model. The server
+// never returns sensitive values, so value is carried over from the prior
+// model.
+func flattenVariableResourceModel(ctx context.Context, spaceID string, resource *variables.Variable, value types.String) (*VariableResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := VariableResourceModel{
+		SpaceID:     types.StringValue(spaceID),
+		ID:          types.StringValue(resource.ID),
+		Name:        types.StringValue(resource.Name),
+		Type:        types.StringValue(resource.Type),
+		Value:       types.StringValue(resource.Value),
+		IsSensitive: types.BoolValue(resource.IsSensitive),
+		Description: types.StringValue(resource.Description),
+		Prompt:      flattenVariablePrompt(resource.Prompt),
+	}
+
+	if resource.IsSensitive {
+		model.Value = value
+	}
+
+	var nestedDiags diag.Diagnostics
+	model.Scope, nestedDiags = flattenVariableScope(ctx, resource.Scope)
+	if diags.Append(nestedDiags...); diags.HasError() {
+		return nil, diags
+	}
+
+	return &model, diags
+}
+
+// setState flattens the variable into the state of the resource.
+func (r *variableResource[M]) setState(ctx context.Context, state *tfsdk.State, spaceID, ownerID string, variable *variables.Variable, value types.String) diag.Diagnostics {
+	model, diags := flattenVariableResourceModel(ctx, spaceID, variable, value)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, r.owner.join(*model, ownerID))...)
+	return diags
+}
+
+func (r *variableResource[M]) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + r.owner.typeName
+}
+
+func (r *variableResource[M]) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Use this resource to manage a single variable in %s, leaving the other variables in the set untouched", r.owner.description),
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("ID of the space that the %s belongs to", r.owner.name),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the variable within the variable set",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			r.owner.attribute: schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("ID of the %s that owns the variable", r.owner.name),
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the variable",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the variable, one of `%s`. `String` is shown as Text in the portal", strings.Join(variableTypes, "`, `")),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("String"),
+				Validators:          []validator.String{stringvalidator.OneOf(variableTypes...)},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the variable. For account, certificate and worker pool variables this is the ID of the referenced resource",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the value is sensitive, which is the case when `type` is `Sensitive`",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the variable",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"prompt": variablePromptResourceAttribute(),
+			"scope":  variableScopeResourceAttribute(),
+		},
+	}
+}
+
+func (r *variableResource[M]) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*OctopusDeployProviderData)
+	if !ok {
+		res.Diagnostics.Append(ErrUnexpectedResourceConfigureType(req.ProviderData))
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *variableResource[M]) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider(fmt.Sprintf("create %s variable", r.owner.name)))
+		return
+	}
+
+	var planModel M
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan, ownerID := r.owner.split(planModel)
+	variable, diags := expandVariableResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	// variable IDs are assigned by the client so the new variable can be found
+	// in the returned set regardless of its position
+	variable.ID = uuid.NewString()
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("creating %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "name": variable.Name, "id": variable.ID})
+
+	variableSet, err := updateVariableSet(ctx, r.client, spaceID, ownerID, func(variableSet *variables.VariableSet) (bool, error) {
+		variableSet.Variables = append(variableSet.Variables, variable)
+		return true, nil
+	})
+	if res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to create %s variable", r.owner.name), err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	created := findVariable(variableSet, variable.ID)
+	if created == nil {
+		err := fmt.Errorf("variable %s not found in variable set %s after update", variable.ID, variableSet.ID)
+		res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to create %s variable", r.owner.name), err)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("created %s variable", r.owner.name), map[string]interface{}{"variable": created})
+
+	if res.Diagnostics.Append(r.setState(ctx, &res.State, spaceID, ownerID, created, plan.Value)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *variableResource[M]) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var stateModel M
+	if res.Diagnostics.Append(req.State.Get(ctx, &stateModel)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	state, ownerID := r.owner.split(stateModel)
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	variableID := state.ID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("fetching %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "id": variableID, "space_id": spaceID})
+
+	variableSet, err := variables.GetAll(r.client, spaceID, ownerID)
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to get %s variables", r.owner.name), err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	variable := findVariable(&variableSet, variableID)
+	if variable == nil {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("fetched %s variable", r.owner.name), map[string]interface{}{"variable": variable})
+
+	if res.Diagnostics.Append(r.setState(ctx, &res.State, spaceID, ownerID, variable, state.Value)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *variableResource[M]) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider(fmt.Sprintf("update %s variable", r.owner.name)))
+		return
+	}
+
+	var planModel M
+	if res.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	plan, ownerID := r.owner.split(planModel)
+	variable, diags := expandVariableResourceModel(ctx, plan)
+	if res.Diagnostics.Append(diags...); res.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := resolveSpaceID(r.client, plan.SpaceID.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("updating %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "name": variable.Name, "id": variable.ID})
+
+	variableSet, err := updateVariableSet(ctx, r.client, spaceID, ownerID, func(variableSet *variables.VariableSet) (bool, error) {
+		return true, replaceVariable(variableSet, variable)
+	})
+	if res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to update %s variable", r.owner.name), err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	updated := findVariable(variableSet, variable.ID)
+	if updated == nil {
+		err := fmt.Errorf("variable %s not found in variable set %s after update", variable.ID, variableSet.ID)
+		res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to update %s variable", r.owner.name), err)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("updated %s variable", r.owner.name), map[string]interface{}{"variable": updated})
+
+	if res.Diagnostics.Append(r.setState(ctx, &res.State, spaceID, ownerID, updated, plan.Value)...); res.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *variableResource[M]) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	if r.readOnly {
+		res.Diagnostics.Append(ErrReadOnlyProvider(fmt.Sprintf("delete %s variable", r.owner.name)))
+		return
+	}
+
+	var stateModel M
+	if res.Diagnostics.Append(req.State.Get(ctx, &stateModel)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	state, ownerID := r.owner.split(stateModel)
+	spaceID := resolveSpaceID(r.client, state.SpaceID.ValueString())
+	variableID := state.ID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("deleting %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "id": variableID})
+
+	_, err := updateVariableSet(ctx, r.client, spaceID, ownerID, func(variableSet *variables.VariableSet) (bool, error) {
+		return removeVariable(variableSet, variableID), nil
+	})
+	if isAPIErrorNotFound(err) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to delete %s variable", r.owner.name), err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("deleted %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "id": variableID})
+}
+
+func (r *variableResource[M]) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	spaceID, id := parseImportID(req.ID)
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		res.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s variable", r.owner.name),
+			fmt.Sprintf("ID should be in the form [space_id/]%s:variable_id", r.owner.attribute),
+		)
+		return
+	}
+
+	spaceID = resolveSpaceID(r.client, spaceID)
+	ownerID := parts[0]
+	variableID := parts[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("importing %s variable", r.owner.name), map[string]interface{}{r.owner.attribute: ownerID, "id": variableID, "space_id": spaceID})
+
+	variable, err := variables.GetByID(r.client, spaceID, ownerID, variableID)
+	if isAPIErrorNotFound(err) {
+		res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to find %s variable", r.owner.name), err)...)
+		return
+	}
+
+	if res.Diagnostics.Append(ErrAsDiagnostic(fmt.Sprintf("Failed to get %s variable", r.owner.name), err)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("imported %s variable", r.owner.name), map[string]interface{}{"variable": variable})
+
+	if res.Diagnostics.Append(r.setState(ctx, &res.State, spaceID, ownerID, variable, types.StringValue(""))...); res.Diagnostics.HasError() {
+		return
+	}
+}